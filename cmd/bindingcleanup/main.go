@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/kyma-project/kyma-environment-broker/internal/bindingcleanup"
+	broker "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
+	"github.com/kyma-project/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/kyma-environment-broker/internal/kubeconfig"
+	"github.com/kyma-project/kyma-environment-broker/internal/schemamigrator/cleaner"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+/**
+Binding cleanup is a job which removes expired Kyma bindings.
+For bindings of the service_account type it removes the ServiceAccount, ClusterRole and ClusterRoleBinding from the Kyma runtime,
+then it removes the binding from the database.
+It expects the following environment variables:
+- APP_DRY_RUN: if set to true, the job only logs the bindings it would remove
+- APP_PUSHGATEWAY_URL: the URL of the Prometheus Pushgateway the job metrics are pushed to, metrics are not pushed if empty
+- APP_DATABASE_*: the database connection configuration
+*/
+
+type Config struct {
+	Database       storage.Config
+	DryRun         bool   `envconfig:"default=true"`
+	PushgatewayURL string `envconfig:"optional"`
+}
+
+func main() {
+	log.SetFormatter(&log.JSONFormatter{})
+	log.Info("Starting binding cleanup job")
+
+	var cfg Config
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	fatalOnError(err)
+
+	if cfg.DryRun {
+		log.Info("Dry run only - no changes")
+	}
+
+	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	db, conn, err := storage.NewFromConfig(cfg.Database, events.Config{}, cipher, log.WithField("service", "storage"))
+	fatalOnError(err)
+
+	kcpK8sConfig, err := config.GetConfig()
+	fatalOnError(err)
+	kcpK8sClient, err := client.New(kcpK8sConfig, client.Options{})
+	fatalOnError(err)
+	skrK8sClientProvider := kubeconfig.NewK8sClientFromSecretProvider(kcpK8sClient)
+
+	registry := prometheus.NewRegistry()
+	metrics := bindingcleanup.NewMetrics(registry)
+
+	svc := bindingcleanup.NewService(cfg.DryRun, db.Bindings(), db.Instances(),
+		broker.NewServiceAccountBindingsManager(skrK8sClientProvider, skrK8sClientProvider), metrics, log.WithField("service", "binding-cleanup"))
+	err = svc.PerformCleanup()
+	fatalOnError(err)
+
+	if cfg.PushgatewayURL != "" {
+		err = push.New(cfg.PushgatewayURL, "binding_cleanup").Gatherer(registry).Push()
+		logOnError(err)
+	}
+
+	log.Info("Binding cleanup job finished successfully!")
+
+	err = conn.Close()
+	fatalOnError(err)
+
+	err = cleaner.HaltIstioSidecar()
+	logOnError(err)
+	// do not use defer, close must be done before halting
+	err = cleaner.Halt()
+	fatalOnError(err)
+}
+
+func fatalOnError(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func logOnError(err error) {
+	if err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+
+	bindings "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/binding"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewBindingProcessingQueue(ctx context.Context, workersAmount int, cfg *Config, db storage.BrokerStorage, clientProvider K8sClientProvider,
+	kubeconfigProvider KubeconfigProvider, gardenerClient client.Client, logs logrus.FieldLogger) *process.Queue {
+
+	processor := binding.NewProcessor(db.Bindings(), db.Instances(),
+		bindings.NewServiceAccountBindingsManager(clientProvider, kubeconfigProvider),
+		bindings.NewGardenerBindingManager(gardenerClient),
+		cfg.Broker.Binding.OperationTimeout, logs.WithField("binding", "processor"))
+
+	queue := newProcessingQueue("bindings", processor, cfg.QueueLeases, db, logs)
+	queue.Run(ctx.Done(), workersAmount)
+
+	return queue
+}
@@ -95,7 +95,7 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 		}
 	}
 
-	queue := process.NewQueue(deprovisionManager, logs)
+	queue := newProcessingQueue("deprovisioning", deprovisionManager, cfg.QueueLeases, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
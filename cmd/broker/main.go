@@ -20,6 +20,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/dlmiddlecote/sqlstats"
 	shoot "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/google/uuid"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/kyma-environment-broker/common/gardener"
@@ -29,6 +30,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/appinfo"
 	"github.com/kyma-project/kyma-environment-broker/internal/broker"
 	kebConfig "github.com/kyma-project/kyma-environment-broker/internal/config"
+	"github.com/kyma-project/kyma-environment-broker/internal/configwatcher"
 	"github.com/kyma-project/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/kyma-environment-broker/internal/edp"
 	"github.com/kyma-project/kyma-environment-broker/internal/event"
@@ -41,15 +43,18 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/notification"
 	"github.com/kyma-project/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/kyma-environment-broker/internal/orchestration/handlers"
+	"github.com/kyma-project/kyma-environment-broker/internal/orchestration/manager"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/kyma-environment-broker/internal/provider"
 	"github.com/kyma-project/kyma-environment-broker/internal/provisioner"
+	hyperscalerRegions "github.com/kyma-project/kyma-environment-broker/internal/regions"
 	"github.com/kyma-project/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/kyma-environment-broker/internal/suspension"
 	"github.com/kyma-project/kyma-environment-broker/internal/swagger"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -109,6 +114,10 @@ type Config struct {
 	InfrastructureManagerIntegrationDisabled   bool   `envconfig:"default=true"`
 	Broker                                     broker.Config
 	CatalogFilePath                            string
+	PlansDefinitionsFilePath                   string
+	RegionsFilePath                            string
+	ConfigReloadInterval                       time.Duration `envconfig:"default=1m"`
+	QueueLeases                                process.LeaseConfig
 
 	EDP edp.Config
 
@@ -253,6 +262,8 @@ func main() {
 	fatalOnError(err, logs)
 	kcpK8sClient, err := initClient(kcpK8sConfig)
 	fatalOnError(err, logs)
+	dynamicKcp, err := dynamic.NewForConfig(kcpK8sConfig)
+	fatalOnError(err, logs)
 	skrK8sClientProvider := kubeconfig.NewK8sClientFromSecretProvider(kcpK8sClient)
 
 	// create storage
@@ -300,9 +311,15 @@ func main() {
 
 	oidcDefaultValues, err := runtime.ReadOIDCDefaultValuesFromYAML(cfg.SkrOidcDefaultValuesYAMLFilePath)
 	fatalOnError(err, logs)
-	inputFactory, err := input.NewInputBuilderFactory(configProvider, cfg.Provisioner, regions, cfg.FreemiumProviders, oidcDefaultValues, cfg.Broker.UseSmallerMachineTypes)
+	reloadableConfig := input.NewReloadableConfig(regions, oidcDefaultValues)
+	inputFactory, err := input.NewInputBuilderFactory(configProvider, cfg.Provisioner, reloadableConfig, cfg.FreemiumProviders, cfg.Broker.UseSmallerMachineTypes)
 	fatalOnError(err, logs)
 
+	// reloads file based configuration when the mounted files change
+	configWatcher := configwatcher.New(cfg.ConfigReloadInterval, configwatcher.NewMetrics(prometheus.DefaultRegisterer), logs)
+	configWatcher.Add("trial region mapping", cfg.TrialRegionMappingFilePath, reloadableConfig.ReloadTrialPlatformRegionMapping)
+	configWatcher.Add("OIDC default values", cfg.SkrOidcDefaultValuesYAMLFilePath, reloadableConfig.ReloadOIDCDefaultValues)
+
 	edpClient := edp.NewClient(cfg.EDP)
 
 	// application event broker
@@ -314,7 +331,7 @@ func main() {
 	// run queues
 	provisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, cfg.Provisioning, logs.WithField("provisioning", "manager"))
 	provisionQueue := NewProvisioningProcessingQueue(ctx, provisionManager, cfg.Provisioning.WorkersAmount, &cfg, db, provisionerClient, inputFactory,
-		edpClient, accountProvider, skrK8sClientProvider, kcpK8sClient, oidcDefaultValues, logs)
+		edpClient, accountProvider, skrK8sClientProvider, kcpK8sClient, reloadableConfig, logs)
 
 	deprovisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, cfg.Deprovisioning, logs.WithField("deprovisioning", "manager"))
 	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, cfg.Deprovisioning.WorkersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient, edpClient, accountProvider,
@@ -322,17 +339,36 @@ func main() {
 
 	updateManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, cfg.Update, logs.WithField("update", "manager"))
 	updateQueue := NewUpdateProcessingQueue(ctx, updateManager, cfg.Update.WorkersAmount, db, inputFactory, provisionerClient, eventBroker,
-		cfg, skrK8sClientProvider, kcpK8sClient, logs)
+		cfg, skrK8sClientProvider, kcpK8sClient, reloadableConfig, logs)
+
+	bindingQueue := NewBindingProcessingQueue(ctx, cfg.Broker.Binding.WorkersAmount, &cfg, db, skrK8sClientProvider, skrK8sClientProvider, gardenerClient, logs)
 	/***/
 	servicesConfig, err := broker.NewServicesConfigFromFile(cfg.CatalogFilePath)
 	fatalOnError(err, logs)
+	if cfg.RegionsFilePath != "" {
+		regionsConfig, err := hyperscalerRegions.NewConfigFromFile(cfg.RegionsFilePath)
+		fatalOnError(err, logs)
+		fatalOnError(hyperscalerRegions.Set(regionsConfig), logs)
+		configWatcher.Add("regions", cfg.RegionsFilePath, hyperscalerRegions.Reload)
+	}
+	if cfg.PlansDefinitionsFilePath != "" {
+		planDefinitions, err := broker.NewPlanDefinitionsFromFile(cfg.PlansDefinitionsFilePath)
+		fatalOnError(err, logs)
+		fatalOnError(broker.RegisterPlanDefinitions(planDefinitions), logs)
+		logs.Infof("Plans loaded from the plans definitions file: %d", len(planDefinitions))
+	}
+	fatalOnError(cfg.Broker.EnablePlans.Validate(), logs)
+	fatalOnError(cfg.Broker.Binding.BindablePlans.Validate(), logs)
+	fatalOnError(cfg.Broker.PlanUpgrades.Validate(), logs)
+	fatalOnError(cfg.Broker.RuntimeVersions.Validate(), logs)
 
 	// create kubeconfig builder
 	kcBuilder := kubeconfig.NewBuilder(provisionerClient, kcpK8sClient, skrK8sClientProvider)
 
 	// create server
 	router := mux.NewRouter()
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults, kcBuilder, skrK8sClientProvider, skrK8sClientProvider, gardenerClient, kcpK8sClient)
+	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, bindingQueue, logger, logs, inputFactory.GetPlanDefaults, kcBuilder, skrK8sClientProvider, skrK8sClientProvider, gardenerClient, kcpK8sClient, configWatcher)
+	go configWatcher.Run(ctx)
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
@@ -342,13 +378,16 @@ func main() {
 	kcHandler.AttachRoutes(router)
 
 	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
-	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
+	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, dynamicKcp, runtimeLister, logs)
 
 	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
 		nil, time.Minute, runtimeResolver, notificationBuilder, logs, kcpK8sClient, cfg, 1)
+	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, eventBroker, time.Minute, runtimeResolver, notificationBuilder, logs, kcpK8sClient, cfg, 1)
+
+	orchestrationPreviewer := manager.NewPreviewer(db.Instances(), runtimeResolver, kcpK8sClient, cfg.OrchestrationConfig, logs)
 
 	// TODO: in case of cluster upgrade the same Azure Zones must be send to the Provisioner
-	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, clusterQueue, cfg.MaxPaginationPage, logs)
+	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, clusterQueue, kymaQueue, orchestrationPreviewer, cfg.MaxPaginationPage, logs)
 
 	if !cfg.DisableProcessOperationsInProgress {
 		err = processOperationsInProgressByType(internal.OperationTypeProvision, db.Operations(), provisionQueue, logs)
@@ -357,8 +396,12 @@ func main() {
 		fatalOnError(err, logs)
 		err = processOperationsInProgressByType(internal.OperationTypeUpdate, db.Operations(), updateQueue, logs)
 		fatalOnError(err, logs)
+		err = processBindingsInProgress(db.Bindings(), bindingQueue, logs)
+		fatalOnError(err, logs)
 		err = reprocessOrchestrations(orchestrationExt.UpgradeClusterOrchestration, db.Orchestrations(), db.Operations(), clusterQueue, logs)
 		fatalOnError(err, logs)
+		err = reprocessOrchestrations(orchestrationExt.UpgradeKymaOrchestration, db.Orchestrations(), db.Operations(), kymaQueue, logs)
+		fatalOnError(err, logs)
 	} else {
 		logger.Info("Skipping processing operation in progress on start")
 	}
@@ -375,7 +418,7 @@ func main() {
 
 	// create list runtimes endpoint
 	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(),
-		db.RuntimeStates(), db.InstancesArchived(), cfg.MaxPaginationPage,
+		db.RuntimeStates(), db.InstancesArchived(), db.Bindings(), cfg.MaxPaginationPage,
 		cfg.DefaultRequestRegion, provisionerClient,
 		kcpK8sClient,
 		cfg.Broker.KimConfig,
@@ -410,7 +453,7 @@ func logConfiguration(logs *logrus.Logger, cfg Config) {
 	logs.Infof("Is UpdateCustomResourcesLabelsOnAccountMove enabled: %t", cfg.Broker.UpdateCustomResourcesLabelsOnAccountMove)
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults, kcBuilder kubeconfig.KcBuilder, clientProvider K8sClientProvider, kubeconfigProvider KubeconfigProvider, gardenerClient, kcpK8sClient client.Client) {
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue, bindingQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults, kcBuilder kubeconfig.KcBuilder, clientProvider K8sClientProvider, kubeconfigProvider KubeconfigProvider, gardenerClient, kcpK8sClient client.Client, configWatcher *configwatcher.Watcher) {
 	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
@@ -445,12 +488,47 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 			planDefaults, logs, cfg.KymaDashboardConfig, kcBuilder, convergedCloudRegionProvider, kcpK8sClient),
 		GetInstanceEndpoint:          broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), kcBuilder, logs),
 		LastOperationEndpoint:        broker.NewLastOperation(db.Operations(), db.InstancesArchived(), logs),
-		BindEndpoint:                 broker.NewBind(cfg.Broker.Binding, db.Instances(), logs, clientProvider, kubeconfigProvider, gardenerClient),
-		UnbindEndpoint:               broker.NewUnbind(logs),
-		GetBindingEndpoint:           broker.NewGetBinding(logs),
-		LastBindingOperationEndpoint: broker.NewLastBindingOperation(logs),
+		BindEndpoint:                 broker.NewBind(cfg.Broker.Binding, db.Instances(), db.Bindings(), logs, clientProvider, kubeconfigProvider, gardenerClient, bindingQueue),
+		UnbindEndpoint:               broker.NewUnbind(cfg.Broker.Binding, logs, db.Instances(), db.Bindings(), clientProvider, kubeconfigProvider, gardenerClient, bindingQueue),
+		GetBindingEndpoint:           broker.NewGetBinding(logs, db.Bindings()),
+		LastBindingOperationEndpoint: broker.NewLastBindingOperation(logs, db.Bindings()),
 	}
 
+	configWatcher.Add("catalog", cfg.CatalogFilePath, func(path string) error {
+		servicesConfig, err := broker.NewServicesConfigFromFile(path)
+		if err != nil {
+			return err
+		}
+		plansConfig, err := servicesConfig.DefaultPlansConfig()
+		if err != nil {
+			return err
+		}
+		kymaEnvBroker.ServicesEndpoint.SetServicesConfig(servicesConfig)
+		kymaEnvBroker.ProvisionEndpoint.SetPlansConfig(plansConfig)
+		kymaEnvBroker.UpdateEndpoint.SetPlansConfig(plansConfig)
+		return nil
+	})
+	configWatcher.Add("DNS providers", cfg.SkrDnsProvidersValuesYAMLFilePath, func(path string) error {
+		dnsProviders, err := gardener.ReadDNSProvidersValuesFromYAML(path)
+		if err != nil {
+			return err
+		}
+		if err := dnsProviders.Validate(); err != nil {
+			return err
+		}
+		kymaEnvBroker.ProvisionEndpoint.SetDNSProviders(dnsProviders)
+		return nil
+	})
+	configWatcher.Add("freemium whitelist", cfg.FreemiumWhitelistedGlobalAccountsFilePath, func(path string) error {
+		freemiumGlobalAccountIds, err := whitelist.ReadWhitelistedGlobalAccountIdsFromFile(path)
+		if err != nil {
+			return err
+		}
+		kymaEnvBroker.ProvisionEndpoint.SetFreemiumWhitelist(freemiumGlobalAccountIds)
+		return nil
+	})
+	configWatcher.Add("sap-converged-cloud region mappings", cfg.SapConvergedCloudRegionMappingsFilePath, convergedCloudRegionProvider.Reload)
+
 	router.Use(middleware.AddRegionToContext(cfg.DefaultRequestRegion))
 	router.Use(middleware.AddProviderToContext())
 	for _, prefix := range []string{
@@ -467,6 +545,21 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 	router.Handle("/events", eventshandler.NewHandler(db.Events(), db.Instances()))
 }
 
+// newProcessingQueue creates the queue kept in memory of the broker replica or, when queue leases are enabled,
+// the queue stored in the database which items are processed by any of the broker replicas
+func newProcessingQueue(name string, executor process.Executor, leaseConfig process.LeaseConfig, db storage.BrokerStorage, logs logrus.FieldLogger) *process.Queue {
+	if !leaseConfig.Enabled {
+		return process.NewQueue(executor, logs)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "keb"
+	}
+	owner := fmt.Sprintf("%s-%s", hostname, uuid.New().String())
+	logs.Infof("Operations of the %s queue are claimed from the database as %s", name, owner)
+	return process.NewLeasedQueue(executor, db.QueueLeases(), name, owner, leaseConfig, logs)
+}
+
 // queues all in progress operations by type
 func processOperationsInProgressByType(opType internal.OperationType, op storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
 	operations, err := op.GetNotFinishedOperationsByType(opType)
@@ -480,6 +573,19 @@ func processOperationsInProgressByType(opType internal.OperationType, op storage
 	return nil
 }
 
+// queues all in progress binding operations
+func processBindingsInProgress(bindings storage.Bindings, queue *process.Queue, log logrus.FieldLogger) error {
+	inProgress, err := bindings.ListByOperationState(domain.InProgress)
+	if err != nil {
+		return fmt.Errorf("while getting in progress bindings from storage: %w", err)
+	}
+	for _, binding := range inProgress {
+		queue.Add(binding.ID)
+		log.Infof("Resuming the processing of %s operation for binding ID: %s", binding.OperationType, binding.ID)
+	}
+	return nil
+}
+
 func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrationsStorage storage.Orchestrations, operationsStorage storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
 	if err := processCancelingOrchestrations(orchestrationType, orchestrationsStorage, operationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing canceled %s orchestrations: %w", orchestrationType, err)
@@ -487,6 +593,10 @@ func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrat
 	if err := processOrchestration(orchestrationType, orchestrationExt.InProgress, orchestrationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing in progress %s orchestrations: %w", orchestrationType, err)
 	}
+	// paused orchestrations are processed to finish their in progress operations and to wait for the resume
+	if err := processOrchestration(orchestrationType, orchestrationExt.Paused, orchestrationsStorage, queue, log); err != nil {
+		return fmt.Errorf("while processing paused %s orchestrations: %w", orchestrationType, err)
+	}
 	if err := processOrchestration(orchestrationType, orchestrationExt.Pending, orchestrationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing pending %s orchestrations: %w", orchestrationType, err)
 	}
@@ -534,8 +644,11 @@ func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orc
 	for _, o := range orchestrations {
 		count := 0
 		err = nil
-		if orchestrationType == orchestrationExt.UpgradeClusterOrchestration {
+		switch orchestrationType {
+		case orchestrationExt.UpgradeClusterOrchestration:
 			_, count, _, err = operationsStorage.ListUpgradeClusterOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
+		case orchestrationExt.UpgradeKymaOrchestration:
+			_, count, _, err = operationsStorage.ListOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
 		}
 		if err != nil {
 			return fmt.Errorf("while listing %s operations for orchestration %s: %w", orchestrationType, o.OrchestrationID, err)
@@ -3,9 +3,6 @@ package main
 import (
 	"context"
 
-	"github.com/kyma-project/kyma-environment-broker/internal"
-	"github.com/kyma-project/kyma-environment-broker/internal/provider"
-
 	"github.com/kyma-project/kyma-environment-broker/common/hyperscaler"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/kyma-environment-broker/internal/process/input"
@@ -20,12 +17,7 @@ import (
 func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *process.StagedManager, workersAmount int, cfg *Config,
 	db storage.BrokerStorage, provisionerClient provisioner.Client, inputFactory input.CreatorForPlan,
 	edpClient provisioning.EDPClient, accountProvider hyperscaler.AccountProvider,
-	k8sClientProvider provisioning.K8sClientProvider, cli client.Client, defaultOIDC internal.OIDCConfigDTO, logs logrus.FieldLogger) *process.Queue {
-
-	trialRegionsMapping, err := provider.ReadPlatformRegionMappingFromFile(cfg.TrialRegionMappingFilePath)
-	if err != nil {
-		fatalOnError(err, logs)
-	}
+	k8sClientProvider provisioning.K8sClientProvider, cli client.Client, reloadableConfig *input.ReloadableConfig, logs logrus.FieldLogger) *process.Queue {
 
 	const postActionsStageName = "post_actions"
 	provisionManager.DefineStages([]string{startStageName, createRuntimeStageName,
@@ -42,8 +34,6 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 			Once the stage is done it will never be retried.
 	*/
 
-	fatalOnError(err, logs)
-
 	provisioningSteps := []struct {
 		disabled  bool
 		stage     string
@@ -94,7 +84,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		// postcondition: operation.KymaResourceName, operation.RuntimeResourceName is set
 		{
 			stage: createRuntimeStageName,
-			step:  provisioning.NewCreateRuntimeResourceStep(db.Operations(), db.Instances(), cli, cfg.Broker.KimConfig, cfg.Provisioner, trialRegionsMapping, cfg.Broker.UseSmallerMachineTypes, defaultOIDC),
+			step:  provisioning.NewCreateRuntimeResourceStep(db.Operations(), db.Instances(), cli, cfg.Broker.KimConfig, cfg.Provisioner, reloadableConfig, cfg.Broker.UseSmallerMachineTypes),
 		},
 		{
 			stage:     createRuntimeStageName,
@@ -147,7 +137,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		}
 	}
 
-	queue := process.NewQueue(provisionManager, logs)
+	queue := newProcessingQueue("provisioning", provisionManager, cfg.QueueLeases, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
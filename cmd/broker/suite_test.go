@@ -137,7 +137,7 @@ func NewOrchestrationSuite(t *testing.T, additionalKymaVersions []string) *Orche
 		ProvisioningTimeout:         time.Minute,
 		URL:                         "http://localhost",
 		DefaultGardenerShootPurpose: "testing",
-	}, map[string]string{"cf-eu10": "europe"}, cfg.FreemiumProviders, oidcDefaults, cfg.Broker.UseSmallerMachineTypes)
+	}, input.NewReloadableConfig(map[string]string{"cf-eu10": "europe"}, oidcDefaults), cfg.FreemiumProviders, cfg.Broker.UseSmallerMachineTypes)
 	require.NoError(t, err)
 
 	gardenerClient := gardener.NewDynamicFakeClient()
@@ -148,7 +148,7 @@ func NewOrchestrationSuite(t *testing.T, additionalKymaVersions []string) *Orche
 	eventBroker := event.NewPubSub(logs)
 
 	runtimeLister := kebOrchestration.NewRuntimeLister(db.Instances(), db.Operations(), kebRuntime.NewConverter(defaultRegion), logs)
-	runtimeResolver := orchestration.NewGardenerRuntimeResolver(gardenerClient, gardenerNamespace, runtimeLister, logs)
+	runtimeResolver := orchestration.NewGardenerRuntimeResolver(gardenerClient, gardenerNamespace, nil, runtimeLister, logs)
 
 	notificationFakeClient := notification.NewFakeClient()
 	notificationBundleBuilder := notification.NewBundleBuilder(notificationFakeClient, cfg.Notification)
@@ -559,6 +559,7 @@ func NewProvisioningSuite(t *testing.T, multiZoneCluster bool, controlPlaneFailu
 		kebConfig.NewConfigMapReader(ctx, cli, logrus.New(), "keb-runtime-config"),
 		kebConfig.NewConfigMapKeysValidator(),
 		kebConfig.NewConfigMapConverter())
+	reloadableConfig := input.NewReloadableConfig(map[string]string{"cf-eu10": "europe"}, oidcDefaults)
 	inputFactory, err := input.NewInputBuilderFactory(configProvider, input.Config{
 		MachineImageVersion:          "coreos",
 		KubernetesVersion:            "1.18",
@@ -568,7 +569,7 @@ func NewProvisioningSuite(t *testing.T, multiZoneCluster bool, controlPlaneFailu
 		DefaultGardenerShootPurpose:  "testing",
 		MultiZoneCluster:             multiZoneCluster,
 		ControlPlaneFailureTolerance: controlPlaneFailureTolerance,
-	}, map[string]string{"cf-eu10": "europe"}, cfg.FreemiumProviders, oidcDefaults, useSmallerMachineTypes)
+	}, reloadableConfig, cfg.FreemiumProviders, useSmallerMachineTypes)
 	require.NoError(t, err)
 
 	assert.NoError(t, err)
@@ -581,7 +582,7 @@ func NewProvisioningSuite(t *testing.T, multiZoneCluster bool, controlPlaneFailu
 
 	provisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, cfg.Provisioning, logs.WithField("provisioning", "manager"))
 	provisioningQueue := NewProvisioningProcessingQueue(ctx, provisionManager, workersAmount, cfg, db, provisionerClient, inputFactory, edpClient, accountProvider,
-		kubeconfig.NewFakeK8sClientProvider(cli), cli, defaultOIDCValues(), logs)
+		kubeconfig.NewFakeK8sClientProvider(cli), cli, reloadableConfig, logs)
 
 	provisioningQueue.SpeedUp(10000)
 	provisionManager.SpeedUp(10000)
@@ -928,6 +929,7 @@ func fixConfig() *Config {
 			Binding: broker.BindingConfig{
 				Enabled:       true,
 				BindablePlans: []string{"aws", "azure"},
+				AllowedRoles:  []string{"cluster-admin"},
 			},
 			AllowUpdateExpiredInstanceWithContext: true,
 			KimConfig: broker.KimConfig{
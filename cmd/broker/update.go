@@ -17,9 +17,9 @@ import (
 
 func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManager, workersAmount int, db storage.BrokerStorage, inputFactory input.CreatorForPlan,
 	provisionerClient provisioner.Client, publisher event.Publisher,
-	cfg Config, k8sClientProvider K8sClientProvider, cli client.Client, logs logrus.FieldLogger) *process.Queue {
+	cfg Config, k8sClientProvider K8sClientProvider, cli client.Client, reloadableConfig *input.ReloadableConfig, logs logrus.FieldLogger) *process.Queue {
 
-	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check", "runtime_resource", "check_runtime_resource"})
+	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check", "runtime_resource", "check_runtime_resource", "kyma_resource"})
 	updateSteps := []struct {
 		disabled  bool
 		stage     string
@@ -42,7 +42,7 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 		},
 		{
 			stage:     "runtime_resource",
-			step:      update.NewUpdateRuntimeStep(db.Operations(), cli, cfg.UpdateRuntimeResourceDelay),
+			step:      update.NewUpdateRuntimeStep(db.Operations(), cli, cfg.UpdateRuntimeResourceDelay, reloadableConfig),
 			condition: update.SkipForOwnClusterPlan,
 		},
 		{
@@ -50,6 +50,16 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 			step:      steps.NewCheckRuntimeResourceStep(db.Operations(), cli, cfg.Broker.KimConfig, cfg.Provisioner.RuntimeResourceStepTimeout),
 			condition: update.SkipForOwnClusterPlan,
 		},
+		{
+			stage:     "kyma_resource",
+			step:      update.NewUpdateKymaPlanLabelsStep(db.Operations(), cli),
+			condition: update.ForPlanChange,
+		},
+		{
+			stage:     "kyma_resource",
+			step:      update.NewUpdateKymaModulesStep(db.Operations(), cli, inputFactory),
+			condition: update.ForModulesUpdate,
+		},
 	}
 
 	for _, step := range updateSteps {
@@ -60,7 +70,7 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 			}
 		}
 	}
-	queue := process.NewQueue(manager, logs)
+	queue := newProcessingQueue("update", manager, cfg.QueueLeases, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
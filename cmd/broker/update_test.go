@@ -136,7 +136,7 @@ func TestUpdateWithKIM(t *testing.T) {
 	resp = suite.CallAPI("PATCH", fmt.Sprintf("oauth/cf-eu10/v2/service_instances/%s?accepts_incomplete=true", iid),
 		`{
        "service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
-       "plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+       "plan_id": "5cb3d976-b85c-42ea-a636-79cadda109a9",
        "context": {
            "globalaccount_id": "g-account-id",
            "user_id": "john.smith@email.com"
@@ -614,6 +614,157 @@ func TestKymaResourceNameAndGardenerClusterNameAfterUnsuspension(t *testing.T) {
 	suite.AssertKymaResourceExistsByInstanceID(iid)
 }
 
+func TestUpdateParametersWithUnsuspension(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+	expectedAdmins := []string{"newAdmin1@kyma.cx", "newAdmin2@kyma.cx"}
+
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true&plan_id=7d55d31d-35ae-4438-bf13-6ffdfa107d9f&service_id=47c9dcbf-ff30-448e-ab36-d3bad66ba281", iid),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+			"context": {
+				"sm_operator_credentials": {
+					"clientid": "cid",
+					"clientsecret": "cs",
+					"url": "url",
+					"sm_url": "sm_url"
+				},
+				"globalaccount_id": "g-account-id",
+				"subaccount_id": "sub-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"name": "testing-cluster"
+			}
+		}`)
+	opID := suite.DecodeOperationID(resp)
+	suite.processProvisioningByOperationID(opID)
+
+	resp = suite.CallAPI("PATCH", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+       "service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+       "plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+       "context": {
+           "globalaccount_id": "g-account-id",
+           "user_id": "john.smith@email.com",
+           "active": false
+       }
+   }`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	suspensionOpID := suite.WaitForLastOperation(iid, domain.InProgress)
+	suite.FinishDeprovisioningOperationByProvisioner(suspensionOpID)
+	suite.WaitForOperationState(suspensionOpID, domain.Succeeded)
+
+	// when
+	resp = suite.CallAPI("PATCH", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+       "service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+       "plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+       "context": {
+           "globalaccount_id": "g-account-id",
+           "user_id": "john.smith@email.com",
+           "active": true
+       },
+       "parameters": {
+           "administrators": ["newAdmin1@kyma.cx", "newAdmin2@kyma.cx"]
+       }
+   }`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	updateOpID := suite.DecodeOperationID(resp)
+
+	// then
+	suite.processProvisioningByInstanceID(iid)
+	suite.FinishUpdatingOperationByProvisioner(updateOpID)
+	suite.WaitForOperationState(updateOpID, domain.Succeeded)
+
+	updateOp := suite.GetOperation(updateOpID)
+	assert.NotContains(t, updateOp.Description, "preempted")
+	shootUpgrade, found := suite.provisionerClient.LastShootUpgrade(updateOp.RuntimeID)
+	require.True(t, found)
+	assert.Equal(t, expectedAdmins, shootUpgrade.Administrators)
+	suite.AssertInstanceRuntimeAdmins(iid, expectedAdmins)
+}
+
+func TestUpdateParametersWithSuspension(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+	expectedAdmins := []string{"newAdmin1@kyma.cx", "newAdmin2@kyma.cx"}
+
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true&plan_id=7d55d31d-35ae-4438-bf13-6ffdfa107d9f&service_id=47c9dcbf-ff30-448e-ab36-d3bad66ba281", iid),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+			"context": {
+				"sm_operator_credentials": {
+					"clientid": "cid",
+					"clientsecret": "cs",
+					"url": "url",
+					"sm_url": "sm_url"
+				},
+				"globalaccount_id": "g-account-id",
+				"subaccount_id": "sub-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"name": "testing-cluster"
+			}
+		}`)
+	opID := suite.DecodeOperationID(resp)
+	suite.processProvisioningByOperationID(opID)
+
+	// when
+	resp = suite.CallAPI("PATCH", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+       "service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+       "plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+       "context": {
+           "globalaccount_id": "g-account-id",
+           "user_id": "john.smith@email.com",
+           "active": false
+       },
+       "parameters": {
+           "administrators": ["newAdmin1@kyma.cx", "newAdmin2@kyma.cx"]
+       }
+   }`)
+
+	// then
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	suspensionOpID := suite.WaitForLastOperation(iid, domain.InProgress)
+	suite.FinishDeprovisioningOperationByProvisioner(suspensionOpID)
+	suite.WaitForOperationState(suspensionOpID, domain.Succeeded)
+	operations, err := suite.db.Operations().ListOperationsByInstanceID(iid)
+	require.NoError(t, err)
+	for _, op := range operations {
+		assert.NotEqual(t, internal.OperationTypeUpdate, op.Type)
+	}
+	suite.AssertInstanceRuntimeAdmins(iid, expectedAdmins)
+
+	// when
+	resp = suite.CallAPI("PATCH", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+       "service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+       "plan_id": "7d55d31d-35ae-4438-bf13-6ffdfa107d9f",
+       "context": {
+           "globalaccount_id": "g-account-id",
+           "user_id": "john.smith@email.com",
+           "active": true
+       }
+   }`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// then
+	suite.processProvisioningByInstanceID(iid)
+	unsuspension := suite.LastOperation(iid)
+	assert.Equal(t, internal.OperationTypeProvision, unsuspension.Type)
+	assert.Equal(t, expectedAdmins, unsuspension.ProvisioningParameters.Parameters.RuntimeAdministrators)
+	assert.Equal(t, expectedAdmins, suite.fetchProvisionInput().ClusterConfig.Administrators)
+}
+
 func TestUnsuspensionTrialKyma20(t *testing.T) {
 	suite := NewBrokerSuiteTest(t)
 	defer suite.TearDown()
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/upgrade_kyma"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, pub event.Publisher, pollingInterval time.Duration,
+	runtimeResolver orchestrationExt.RuntimeResolver, notificationBuilder notification.BundleBuilder, logs logrus.FieldLogger,
+	cli client.Client, cfg Config, speedFactor int) *process.Queue {
+
+	upgradeKymaManager := upgrade_kyma.NewManager(db.Operations(), pub, logs.WithField("upgradeKyma", "manager"))
+	upgradeKymaManager.InitStep(upgrade_kyma.NewInitialisationStep(db.Operations(), db.Orchestrations()))
+
+	upgradeKymaSteps := []struct {
+		disabled  bool
+		weight    int
+		step      upgrade_kyma.Step
+		condition upgrade_kyma.StepCondition
+	}{
+		{
+			weight: 10,
+			step:   upgrade_kyma.NewUpdateKymaResourceStep(db.Operations(), db.Instances(), db.Orchestrations(), cli),
+		},
+	}
+
+	for _, step := range upgradeKymaSteps {
+		if !step.disabled {
+			upgradeKymaManager.AddStep(step.weight, step.step, step.condition)
+		}
+	}
+
+	orchestrateKymaManager := manager.NewUpgradeKymaManager(db.Orchestrations(), db.Operations(), db.Instances(),
+		upgradeKymaManager, runtimeResolver, pollingInterval, logs.WithField("upgradeKyma", "orchestration"),
+		cli, cfg.OrchestrationConfig, notificationBuilder, speedFactor)
+	queue := newProcessingQueue("upgrade-kyma-orchestrations", orchestrateKymaManager, cfg.QueueLeases, db, logs)
+
+	queue.Run(ctx.Done(), 3)
+
+	return queue
+}
@@ -72,6 +72,15 @@ func (b Shoot) GetSpecRegion() string {
 	return str
 }
 
+func (b Shoot) GetSpecKubernetesVersion() string {
+	str, _, err := unstructured.NestedString(b.Unstructured.Object, "spec", "kubernetes", "version")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.kubernetes.version': %v", err))
+	}
+	return str
+}
+
 var SecretBindingResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "secretbindings"}
 var ShootResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "shoots"}
 
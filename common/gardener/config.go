@@ -1,5 +1,7 @@
 package gardener
 
+import "fmt"
+
 type Config struct {
 	Project        string           `envconfig:"default=gardenerProject"`
 	ShootDomain    string           `envconfig:"optional"`
@@ -17,3 +19,13 @@ type DNSProviderData struct {
 	SecretName     string   `json:"secretName" yaml:"secretName"`
 	Type           string   `json:"type" yaml:"type"`
 }
+
+// Validate checks if every DNS provider has a type and a secret
+func (d DNSProvidersData) Validate() error {
+	for i, provider := range d.Providers {
+		if provider.Type == "" || provider.SecretName == "" {
+			return fmt.Errorf("DNS provider %d must contain type and secretName", i)
+		}
+	}
+	return nil
+}
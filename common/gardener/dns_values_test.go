@@ -43,3 +43,18 @@ func TestReadDNSProvidersValuesFromYAML(t *testing.T) {
 		assert.Equal(t, DNSProvidersData{}, dnsProvidersValues)
 	})
 }
+
+func TestDNSProvidersData_Validate(t *testing.T) {
+	// given
+	values, err := ReadDNSProvidersValuesFromYAML("testdata/dns-values.yaml")
+	require.NoError(t, err)
+
+	// when/then
+	assert.NoError(t, values.Validate())
+
+	// given
+	values.Providers = append(values.Providers, DNSProviderData{Type: "aws-route53"})
+
+	// when/then
+	assert.EqualError(t, values.Validate(), "DNS provider 1 must contain type and secretName")
+}
@@ -3,10 +3,12 @@ package orchestration
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // Parameters hold the attributes of orchestration create (upgrade) requests.
@@ -15,6 +17,7 @@ type Parameters struct {
 	Strategy       StrategySpec             `json:"strategy,omitempty"`
 	DryRun         bool                     `json:"dryRun,omitempty"`
 	Kubernetes     *KubernetesParameters    `json:"kubernetes,omitempty"`
+	Kyma           *KymaParameters          `json:"kyma,omitempty"`
 	RetryOperation RetryOperationParameters `json:"retryoperation,omitempty"`
 	// customer notification
 	Notification bool `json:"notification,omitempty"`
@@ -31,6 +34,37 @@ type KubernetesParameters struct {
 	MachineImageVersion string `json:"machineImageVersion"`
 }
 
+// KymaParameters defines changes applied to the Kyma resources of the targeted runtimes
+type KymaParameters struct {
+	// Channel replaces the spec.channel of the Kyma resource
+	Channel string `json:"channel,omitempty"`
+	// Modules are added to the spec.modules of the Kyma resource, or replace the modules with the same name
+	Modules []KymaModule `json:"modules,omitempty"`
+	// RemoveModules are names of modules removed from the spec.modules of the Kyma resource
+	RemoveModules []string `json:"removeModules,omitempty"`
+}
+
+type KymaModule struct {
+	Name                 string `json:"name"`
+	Channel              string `json:"channel,omitempty"`
+	CustomResourcePolicy string `json:"customResourcePolicy,omitempty"`
+}
+
+func (p *KymaParameters) Validate() error {
+	if p.Channel == "" && len(p.Modules) == 0 && len(p.RemoveModules) == 0 {
+		return fmt.Errorf("channel, modules or removeModules must be provided")
+	}
+	for _, m := range p.Modules {
+		if m.Name == "" {
+			return fmt.Errorf("module name must not be empty")
+		}
+		if slices.Contains(p.RemoveModules, m.Name) {
+			return fmt.Errorf("module %s cannot be both added and removed", m.Name)
+		}
+	}
+	return nil
+}
+
 const (
 	// StateParam parameter used in list orchestrations / operations queries to filter by state
 	StateParam = "state"
@@ -41,6 +75,7 @@ const (
 	Pending    = "pending"
 	InProgress = "in progress"
 	Canceling  = "canceling"
+	Paused     = "paused"   // no new operations are scheduled until the orchestration is resumed
 	Retrying   = "retrying" // to signal a retry sign before marking it to pending
 	Canceled   = "canceled"
 	Succeeded  = "succeeded"
@@ -76,6 +111,31 @@ type RuntimeTarget struct {
 	Shoot string `json:"shoot,omitempty"`
 	// InstanceID is used to identify an instance by it's instance ID
 	InstanceID string `json:"instanceID,omitempty"`
+	// LabelSelector is a Kubernetes label selector matched against the labels of the runtime's Kyma resource. E.g. "kyma-project.io/platform-region=cf-eu10,operator.kyma-project.io/beta!=true"
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// KubernetesVersion matches the shoot cluster's Kubernetes version, either exactly or by a version prefix. E.g. "1.29" matches "1.29.4"
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Provider is used to match runtimes running on the given cloud provider. E.g. "AWS", "Azure"
+	Provider string `json:"provider,omitempty"`
+	// LicenseType is used to match runtimes with the given license type. E.g. "SAPDEV", "CUSTOMER"
+	LicenseType string `json:"licenseType,omitempty"`
+	// CreatedBefore is used to match runtimes created before the given point in time
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+	// CreatedAfter is used to match runtimes created after the given point in time
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+}
+
+// Validate checks if the target predicates are well formed.
+func (rt RuntimeTarget) Validate() error {
+	if rt.LabelSelector != "" {
+		if _, err := labels.Parse(rt.LabelSelector); err != nil {
+			return fmt.Errorf("invalid labelSelector %q: %w", rt.LabelSelector, err)
+		}
+	}
+	if rt.CreatedBefore != nil && rt.CreatedAfter != nil && !rt.CreatedAfter.Before(*rt.CreatedBefore) {
+		return fmt.Errorf("createdAfter must be earlier than createdBefore")
+	}
+	return nil
 }
 
 type Type string
@@ -89,6 +149,7 @@ type StrategyType string
 
 const (
 	ParallelStrategy StrategyType = "parallel"
+	CanaryStrategy   StrategyType = "canary"
 )
 
 type ScheduleType string
@@ -104,6 +165,57 @@ type ParallelStrategySpec struct {
 	Workers int `json:"workers"`
 }
 
+// CanaryStrategySpec defines parameters for the canary orchestration strategy.
+// The canary wave is executed first, the remaining operations are split into waves released one by one.
+// Operations of every wave are executed in parallel, according to the parallel strategy parameters.
+type CanaryStrategySpec struct {
+	// Percentage of the targets executed in the canary wave, used if RuntimeIDs are not provided
+	Percentage int `json:"percentage,omitempty"`
+	// RuntimeIDs lists the runtimes executed in the canary wave
+	RuntimeIDs []string `json:"runtimeIDs,omitempty"`
+	// Waves is the number of waves the remaining targets are split into, 1 by default
+	Waves int `json:"waves,omitempty"`
+	// SoakPeriod is the time to wait after a wave is finished before the next wave is released, for example "2h"
+	SoakPeriod string `json:"soakPeriod,omitempty"`
+	// FailureThreshold is the percentage of failed operations in a wave above which the next waves are not released
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+}
+
+// Validate checks the canary strategy parameters
+func (c CanaryStrategySpec) Validate() error {
+	if c.Percentage == 0 && len(c.RuntimeIDs) == 0 {
+		return fmt.Errorf("canary strategy requires percentage or runtimeIDs")
+	}
+	if c.Percentage < 0 || c.Percentage > 100 {
+		return fmt.Errorf("canary percentage must be between 1 and 100")
+	}
+	if c.Waves < 0 {
+		return fmt.Errorf("canary waves must not be negative")
+	}
+	if c.FailureThreshold < 0 || c.FailureThreshold > 100 {
+		return fmt.Errorf("canary failureThreshold must be between 0 and 100")
+	}
+	if _, err := c.SoakDuration(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SoakDuration returns the soak period, zero if it is not provided
+func (c CanaryStrategySpec) SoakDuration() (time.Duration, error) {
+	if c.SoakPeriod == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.SoakPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid canary soakPeriod %s: %w", c.SoakPeriod, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("canary soakPeriod must not be negative")
+	}
+	return d, nil
+}
+
 // StrategySpec is the strategy part common for all orchestration trigger/status API
 type StrategySpec struct {
 	Type              StrategyType `json:"type"`
@@ -111,6 +223,7 @@ type StrategySpec struct {
 	ScheduleTime      time.Time
 	MaintenanceWindow bool                 `json:"maintenanceWindow,omitempty"`
 	Parallel          ParallelStrategySpec `json:"parallel,omitempty"`
+	Canary            *CanaryStrategySpec  `json:"canary,omitempty"`
 }
 
 // TargetSpec is the targets part common for all orchestration trigger/status API
@@ -169,6 +282,18 @@ type UpgradeResponse struct {
 	OrchestrationID string `json:"orchestrationID"`
 }
 
+// PreviewResponse holds the runtimes which would be targeted by an orchestration with the given parameters.
+type PreviewResponse struct {
+	Data  []RuntimePreview `json:"data"`
+	Count int              `json:"count"`
+}
+
+// RuntimePreview holds a resolved runtime with the time its operation would be scheduled at.
+type RuntimePreview struct {
+	Runtime
+	ScheduledAt time.Time `json:"scheduledAt"`
+}
+
 type RetryResponse struct {
 	OrchestrationID   string   `json:"orchestrationID"`
 	RetryShoots       []string `json:"retryShoots"`
@@ -44,6 +44,11 @@ type OperationExecutor interface {
 	Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error
 }
 
+// OperationStateGetter returns the state of the operation, used by strategies evaluating results of already executed operations.
+type OperationStateGetter interface {
+	OperationState(operationID string) (string, error)
+}
+
 // Strategy interface encapsulates the strategy how the orchestration is performed.
 //
 //go:generate mockery --name=Strategy --output=automock --outpkg=automock --case=underscore
@@ -61,6 +66,20 @@ type Strategy interface {
 	SpeedUp(speedFactor int)
 }
 
+// HaltingStrategy is implemented by strategies, which can stop releasing operations on their own, for example, when too many operations failed.
+type HaltingStrategy interface {
+	// Halted returns the reason why the execution with the given ID was halted, nil if it was not halted
+	Halted(executionID string) error
+}
+
+// PausableStrategy is implemented by strategies, which can hold scheduling of operations without canceling them.
+type PausableStrategy interface {
+	// Pause stops scheduling new operations of the given execution, operations already being processed are finished
+	Pause(executionID string)
+	// Resume continues scheduling operations of the given execution
+	Resume(executionID string)
+}
+
 func ConvertSliceOfDaysToMap(days []string) map[time.Weekday]bool {
 	m := make(map[time.Weekday]bool)
 	for _, day := range days {
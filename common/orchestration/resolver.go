@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	brokerapi "github.com/pivotal-cf/brokerapi/v8/domain"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -27,12 +29,15 @@ type RuntimeLister interface {
 // GardenerRuntimeResolver is the default resolver which implements the RuntimeResolver interface.
 // This resolver uses the Shoot resources on the Gardener cluster to resolve the runtime targets.
 //
+// Targets with a label selector are additionally matched against the Kyma resources on the KCP cluster.
+//
 // Naive implementation, listing all the shoots and perfom filtering on the result.
 // The logic could be optimized with k8s client cache using shoot lister / indexer.
 // The implementation is thread safe, i.e. it is safe to call Resolve() from multiple threads concurrently.
 type GardenerRuntimeResolver struct {
 	gardenerClient    dynamic.Interface
 	gardenerNamespace string
+	kcpClient         dynamic.Interface
 	runtimeLister     RuntimeLister
 	runtimes          map[string]runtime.RuntimeDTO
 	mutex             sync.RWMutex
@@ -43,14 +48,18 @@ const (
 	globalAccountLabel      = "account"
 	subAccountLabel         = "subaccount"
 	runtimeIDAnnotation     = "kcp.provisioner.kyma-project.io/runtime-id"
+	kymaRuntimeIDLabel      = "kyma-project.io/runtime-id"
 	maintenanceWindowFormat = "150405-0700"
 )
 
+var kymaResource = schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1beta2", Resource: "kymas"}
+
 // NewGardenerRuntimeResolver constructs a GardenerRuntimeResolver with the mandatory input parameters.
-func NewGardenerRuntimeResolver(gardenerClient dynamic.Interface, gardenerNamespace string, lister RuntimeLister, logger logrus.FieldLogger) *GardenerRuntimeResolver {
+func NewGardenerRuntimeResolver(gardenerClient dynamic.Interface, gardenerNamespace string, kcpClient dynamic.Interface, lister RuntimeLister, logger logrus.FieldLogger) *GardenerRuntimeResolver {
 	return &GardenerRuntimeResolver{
 		gardenerClient:    gardenerClient,
 		gardenerNamespace: gardenerNamespace,
+		kcpClient:         kcpClient,
 		runtimeLister:     lister,
 		runtimes:          map[string]runtime.RuntimeDTO{},
 		logger:            logger.WithField("orchestration", "resolver"),
@@ -109,6 +118,28 @@ func (resolver *GardenerRuntimeResolver) getAllShoots() ([]unstructured.Unstruct
 	return shootList.Items, nil
 }
 
+// getRuntimeIDsBySelector returns IDs of runtimes whose Kyma resources match the given label selector
+func (resolver *GardenerRuntimeResolver) getRuntimeIDsBySelector(selector string) (map[string]bool, error) {
+	if resolver.kcpClient == nil {
+		return nil, fmt.Errorf("label selector %q cannot be resolved: KCP client is not configured", selector)
+	}
+	kymaList, err := resolver.kcpClient.Resource(kymaResource).List(context.Background(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("while listing Kyma resources with label selector %q: %w", selector, err)
+	}
+
+	runtimeIDs := map[string]bool{}
+	for _, kyma := range kymaList.Items {
+		runtimeID := kyma.GetLabels()[kymaRuntimeIDLabel]
+		if runtimeID == "" {
+			runtimeID = kyma.GetName()
+		}
+		runtimeIDs[runtimeID] = true
+	}
+
+	return runtimeIDs, nil
+}
+
 func (resolver *GardenerRuntimeResolver) syncRuntimeOperations() error {
 	runtimes, err := resolver.runtimeLister.ListAllRuntimes()
 	if err != nil {
@@ -134,6 +165,14 @@ func (resolver *GardenerRuntimeResolver) getRuntime(runtimeID string) (runtime.R
 
 func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget, shoots []unstructured.Unstructured) ([]Runtime, error) {
 	runtimes := []Runtime{}
+	var selectedRuntimeIDs map[string]bool
+	if rt.LabelSelector != "" {
+		var err error
+		selectedRuntimeIDs, err = resolver.getRuntimeIDsBySelector(rt.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// Iterate over all shoots. Evaluate target specs. If multiple are specified, all must match for a given shoot.
 	for _, s := range shoots {
 		shoot := &gardener.Shoot{Unstructured: s}
@@ -219,6 +258,37 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 			}
 		}
 
+		// Perform match against Kyma resource labels
+		if selectedRuntimeIDs != nil && !selectedRuntimeIDs[runtimeID] {
+			continue
+		}
+
+		// Perform match against Kubernetes version, exact or by version prefix
+		if rt.KubernetesVersion != "" {
+			version := shoot.GetSpecKubernetesVersion()
+			if version != rt.KubernetesVersion && !strings.HasPrefix(version, rt.KubernetesVersion+".") {
+				continue
+			}
+		}
+
+		// Perform match against a specific Provider
+		if rt.Provider != "" && !strings.EqualFold(rt.Provider, r.Provider) {
+			continue
+		}
+
+		// Perform match against a specific LicenseType
+		if rt.LicenseType != "" && rt.LicenseType != r.LicenseType {
+			continue
+		}
+
+		// Perform match against the runtime creation time
+		if rt.CreatedBefore != nil && !r.Status.CreatedAt.Before(*rt.CreatedBefore) {
+			continue
+		}
+		if rt.CreatedAfter != nil && !r.Status.CreatedAt.After(*rt.CreatedAfter) {
+			continue
+		}
+
 		// Check if target: all is specified
 		if rt.Target != "" && rt.Target != TargetAll {
 			continue
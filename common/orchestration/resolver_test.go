@@ -14,6 +14,7 @@ import (
 	brokerapi "github.com/pivotal-cf/brokerapi/v8/domain"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8s "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -34,14 +35,18 @@ const (
 
 	plan1 = "azure"
 	plan2 = "gcp"
+
+	platformRegionLabel = "kyma-project.io/platform-region"
 )
 
+var runtimesCreatedAt = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
 func TestResolver_Resolve(t *testing.T) {
 	client := newFakeGardenerClient()
 	lister := newRuntimeListerMock()
 	defer lister.AssertExpectations(t)
 	logger := newLogDummy()
-	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, logger)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, newFakeKcpClient(), lister, logger)
 
 	expectedRuntime1 := expectedRuntime{
 		shoot:   &shoot1,
@@ -184,6 +189,110 @@ func TestResolver_Resolve(t *testing.T) {
 			},
 			ExpectedRuntimes: []expectedRuntime{expectedRuntime1},
 		},
+		"IncludeLabelSelector": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						LabelSelector: platformRegionLabel + "=cf-eu10",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1, expectedRuntime3},
+		},
+		"IncludeKubernetesVersion": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						KubernetesVersion: "1.29",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime2, expectedRuntime10},
+		},
+		"IncludeKubernetesVersionPartialSegment": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						KubernetesVersion: "1.2",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{},
+		},
+		"IncludeProvider": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						Provider: "azure",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime2, expectedRuntime3, expectedRuntime10},
+		},
+		"IncludeLicenseType": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						LicenseType: "SAPDEV",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1},
+		},
+		"IncludeCreatedBefore": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						CreatedBefore: ptrTime(runtimesCreatedAt.AddDate(0, 0, 3)),
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1, expectedRuntime2},
+		},
+		"IncludeCreatedAfter": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						CreatedAfter: ptrTime(runtimesCreatedAt.AddDate(0, 0, 2)),
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime3, expectedRuntime10},
+		},
+		"IncludeLabelSelectorAndProvider": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						LabelSelector: platformRegionLabel + "=cf-eu10",
+						Provider:      "Azure",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime3},
+		},
+		"IncludeAllExcludeLabelSelector": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						Target: TargetAll,
+					},
+				},
+				Exclude: []RuntimeTarget{
+					{
+						LabelSelector: platformRegionLabel + "!=cf-eu10",
+					},
+				},
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1, expectedRuntime3},
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			// when
@@ -211,7 +320,7 @@ func TestResolver_Resolve_GardenerFailure(t *testing.T) {
 	lister := newRuntimeListerMock()
 	defer lister.AssertExpectations(t)
 	logger := newLogDummy()
-	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, logger)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, nil, lister, logger)
 
 	// when
 	runtimes, err := resolver.Resolve(TargetSpec{
@@ -228,6 +337,29 @@ func TestResolver_Resolve_GardenerFailure(t *testing.T) {
 	assert.Len(t, runtimes, 0)
 }
 
+func TestResolver_Resolve_LabelSelectorWithoutKcpClient(t *testing.T) {
+	// given
+	client := newFakeGardenerClient()
+	lister := newRuntimeListerMock()
+	defer lister.AssertExpectations(t)
+	logger := newLogDummy()
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, nil, lister, logger)
+
+	// when
+	runtimes, err := resolver.Resolve(TargetSpec{
+		Include: []RuntimeTarget{
+			{
+				LabelSelector: platformRegionLabel + "=cf-eu10",
+			},
+		},
+		Exclude: nil,
+	})
+
+	// then
+	assert.NotNil(t, err)
+	assert.Len(t, runtimes, 0)
+}
+
 func TestResolver_Resolve_StorageFailure(t *testing.T) {
 	// given
 	client := newFakeGardenerClient()
@@ -238,7 +370,7 @@ func TestResolver_Resolve_StorageFailure(t *testing.T) {
 	)
 	defer lister.AssertExpectations(t)
 	logger := newLogDummy()
-	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, logger)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, nil, lister, logger)
 
 	// when
 	runtimes, err := resolver.Resolve(TargetSpec{
@@ -299,6 +431,9 @@ func fixShoot(id int, globalAccountID, region string) unstructured.Unstructured
 			},
 			"spec": map[string]interface{}{
 				"region": region,
+				"kubernetes": map[string]interface{}{
+					"version": fixKubernetesVersion(id),
+				},
 				"maintenance": map[string]interface{}{
 					"timeWindow": map[string]interface{}{
 						"begin": "030000+0000",
@@ -310,6 +445,30 @@ func fixShoot(id int, globalAccountID, region string) unstructured.Unstructured
 	}
 }
 
+func fixKubernetesVersion(id int) string {
+	if id%2 == 0 {
+		return "1.29.4"
+	}
+	return "1.28.9"
+}
+
+func fixKyma(id int, platformRegion string) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.kyma-project.io/v1beta2",
+			"kind":       "Kyma",
+			"metadata": map[string]interface{}{
+				"name":      fmt.Sprintf("runtime-id-%d", id),
+				"namespace": "kcp-system",
+				"labels": map[string]interface{}{
+					kymaRuntimeIDLabel:  fmt.Sprintf("runtime-id-%d", id),
+					platformRegionLabel: platformRegion,
+				},
+			},
+		},
+	}
+}
+
 type runtimeOpState struct {
 	provision    string
 	deprovision  string
@@ -324,7 +483,10 @@ func fixRuntimeDTO(id int, globalAccountID, planName string, state runtimeOpStat
 		GlobalAccountID: globalAccountID,
 		SubAccountID:    fmt.Sprintf("subaccount-id-%d", id),
 		ServicePlanName: planName,
+		Provider:        fixProvider(planName),
+		LicenseType:     fixLicenseType(id),
 		Status: runtime.RuntimeStatus{
+			CreatedAt: runtimesCreatedAt.AddDate(0, 0, id),
 			Provisioning: &runtime.Operation{
 				State:     state.provision,
 				CreatedAt: time.Now(),
@@ -368,6 +530,24 @@ func fixRuntimeDTO(id int, globalAccountID, planName string, state runtimeOpStat
 	return rt
 }
 
+func fixProvider(planName string) string {
+	if planName == plan1 {
+		return "Azure"
+	}
+	return "GCP"
+}
+
+func fixLicenseType(id int) string {
+	if id == 1 {
+		return "SAPDEV"
+	}
+	return "CUSTOMER"
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
 type expectedRuntime struct {
 	shoot   *unstructured.Unstructured
 	runtime *runtime.RuntimeDTO
@@ -389,6 +569,17 @@ func newFakeGardenerClient() *dynamicfake.FakeDynamicClient {
 	return client
 }
 
+func newFakeKcpClient() *dynamicfake.FakeDynamicClient {
+	kyma1 := fixKyma(1, "cf-eu10")
+	kyma2 := fixKyma(2, "cf-us10")
+	kyma3 := fixKyma(3, "cf-eu10")
+	kyma10 := fixKyma(10, "cf-us10")
+
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8s.NewScheme(), map[schema.GroupVersionResource]string{
+		kymaResource: "KymaList",
+	}, &kyma1, &kyma2, &kyma3, &kyma10)
+}
+
 func newRuntimeListerMock() *RuntimeListerMock {
 	lister := &RuntimeListerMock{}
 	lister.On("ListAllRuntimes").Maybe().Return(
@@ -0,0 +1,292 @@
+package strategies
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+)
+
+type CanaryOrchestrationStrategy struct {
+	parallel    orchestration.Strategy
+	states      orchestration.OperationStateGetter
+	executions  map[string]*canaryExecution
+	mux         sync.RWMutex
+	log         logrus.FieldLogger
+	speedFactor int
+}
+
+type canaryExecution struct {
+	// current is the ID of the parallel strategy execution of the current wave
+	current  string
+	halted   error
+	canceled bool
+	cancel   chan struct{}
+	done     chan struct{}
+	// resumed is closed when the paused execution is resumed, nil if the execution is not paused
+	resumed chan struct{}
+}
+
+// NewCanaryOrchestrationStrategy returns a new canary orchestration strategy, which executes operations in waves.
+// The canary wave is executed first, every next wave is released after the soak period, if the failure threshold of the previous wave is not exceeded.
+// Operations of a wave are executed by the parallel orchestration strategy.
+func NewCanaryOrchestrationStrategy(executor orchestration.OperationExecutor, states orchestration.OperationStateGetter, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+	return &CanaryOrchestrationStrategy{
+		parallel:    NewParallelOrchestrationStrategy(executor, log, rescheduleDelay),
+		states:      states,
+		executions:  map[string]*canaryExecution{},
+		log:         log,
+		speedFactor: 1,
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) SpeedUp(factor int) {
+	c.speedFactor = factor
+	c.parallel.SpeedUp(factor)
+}
+
+// Execute splits operations into waves and starts the execution of the canary wave.
+func (c *CanaryOrchestrationStrategy) Execute(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) (string, error) {
+	if len(operations) == 0 {
+		return "", nil
+	}
+	spec := strategySpec.Canary
+	if spec == nil {
+		return "", fmt.Errorf("canary strategy parameters are not provided")
+	}
+	if err := spec.Validate(); err != nil {
+		return "", err
+	}
+	soak, _ := spec.SoakDuration()
+
+	execID := uuid.New().String()
+	execution := &canaryExecution{
+		cancel: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	c.mux.Lock()
+	c.executions[execID] = execution
+	c.mux.Unlock()
+
+	go func() {
+		defer close(execution.done)
+		c.executeWaves(execID, execution, CanaryWaves(operations, *spec), strategySpec, soak, spec.FailureThreshold)
+	}()
+
+	return execID, nil
+}
+
+func (c *CanaryOrchestrationStrategy) executeWaves(execID string, execution *canaryExecution, waves [][]orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec, soak time.Duration, failureThreshold int) {
+	log := c.log.WithField("executionID", execID)
+	for i, wave := range waves {
+		if !c.waitIfPaused(execution) {
+			return
+		}
+		// the wave is released under the mutex, so the canceled execution does not release it
+		// and Cancel or Pause called in the meantime always see the released wave
+		c.mux.Lock()
+		if execution.canceled {
+			c.mux.Unlock()
+			return
+		}
+		log.Infof("releasing wave %d of %d with %d operations", i+1, len(waves), len(wave))
+		waveID, err := c.parallel.Execute(wave, strategySpec)
+		execution.current = waveID
+		if execution.resumed != nil {
+			// the execution was paused while waiting for the wave to be released
+			c.parallel.(orchestration.PausableStrategy).Pause(waveID)
+		}
+		c.mux.Unlock()
+		if err != nil {
+			c.halt(execution, fmt.Errorf("while executing wave %d: %w", i+1, err))
+			return
+		}
+		c.parallel.Wait(waveID)
+
+		failed, err := c.countFailed(wave)
+		if err != nil {
+			c.halt(execution, fmt.Errorf("while evaluating wave %d: %w", i+1, err))
+			return
+		}
+		if failed*100 > failureThreshold*len(wave) {
+			c.halt(execution, fmt.Errorf("%d of %d operations failed in wave %d, the failure threshold of %d%% is exceeded", failed, len(wave), i+1, failureThreshold))
+			return
+		}
+		log.Infof("wave %d finished, %d of %d operations failed", i+1, failed, len(wave))
+
+		if i < len(waves)-1 && soak > 0 {
+			select {
+			case <-execution.cancel:
+				return
+			case <-time.After(time.Duration(int64(soak) / int64(c.speedFactor))):
+			}
+		}
+	}
+}
+
+// waitIfPaused blocks until the paused execution is resumed, returns false if the execution was canceled
+func (c *CanaryOrchestrationStrategy) waitIfPaused(execution *canaryExecution) bool {
+	c.mux.RLock()
+	resumed := execution.resumed
+	c.mux.RUnlock()
+	if resumed == nil {
+		return true
+	}
+	select {
+	case <-execution.cancel:
+		return false
+	case <-resumed:
+		return true
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) countFailed(wave []orchestration.RuntimeOperation) (int, error) {
+	failed := 0
+	for _, op := range wave {
+		state, err := c.states.OperationState(op.ID)
+		if err != nil {
+			return 0, fmt.Errorf("while getting state of operation %s: %w", op.ID, err)
+		}
+		if state == orchestration.Failed {
+			failed++
+		}
+	}
+	return failed, nil
+}
+
+func (c *CanaryOrchestrationStrategy) halt(execution *canaryExecution, reason error) {
+	c.log.Warnf("canary execution halted: %s", reason)
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	execution.halted = reason
+}
+
+// Insert adds operations to the wave being executed
+func (c *CanaryOrchestrationStrategy) Insert(execID string, operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) error {
+	c.mux.RLock()
+	execution, exists := c.executions[execID]
+	c.mux.RUnlock()
+	if !exists {
+		return fmt.Errorf("no canary execution for the execution ID: %s", execID)
+	}
+	select {
+	case <-execution.done:
+		return fmt.Errorf("the canary execution %s is finished", execID)
+	default:
+	}
+	c.mux.RLock()
+	current := execution.current
+	c.mux.RUnlock()
+	return c.parallel.Insert(current, operations, strategySpec)
+}
+
+// Halted returns the reason why the next waves were not released
+func (c *CanaryOrchestrationStrategy) Halted(executionID string) error {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	if execution, exists := c.executions[executionID]; exists {
+		return execution.halted
+	}
+	return nil
+}
+
+func (c *CanaryOrchestrationStrategy) Wait(executionID string) {
+	c.mux.RLock()
+	execution := c.executions[executionID]
+	c.mux.RUnlock()
+	if execution != nil {
+		<-execution.done
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Cancel(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Cancelling canary strategy execution %s", executionID)
+
+	c.mux.Lock()
+	execution := c.executions[executionID]
+	if execution == nil || execution.canceled {
+		c.mux.Unlock()
+		return
+	}
+	execution.canceled = true
+	close(execution.cancel)
+	current := execution.current
+	c.mux.Unlock()
+
+	c.parallel.Cancel(current)
+}
+
+// Pause stops releasing next waves and scheduling operations of the current wave
+func (c *CanaryOrchestrationStrategy) Pause(executionID string) {
+	c.mux.Lock()
+	execution := c.executions[executionID]
+	if execution == nil || execution.resumed != nil {
+		c.mux.Unlock()
+		return
+	}
+	execution.resumed = make(chan struct{})
+	current := execution.current
+	c.mux.Unlock()
+
+	c.parallel.(orchestration.PausableStrategy).Pause(current)
+}
+
+// Resume continues the paused execution
+func (c *CanaryOrchestrationStrategy) Resume(executionID string) {
+	c.mux.Lock()
+	execution := c.executions[executionID]
+	if execution == nil || execution.resumed == nil {
+		c.mux.Unlock()
+		return
+	}
+	close(execution.resumed)
+	execution.resumed = nil
+	current := execution.current
+	c.mux.Unlock()
+
+	c.parallel.(orchestration.PausableStrategy).Resume(current)
+}
+
+// CanaryWaves splits operations into waves, the first one is the canary wave. The canary wave contains operations of the runtimes listed in the spec,
+// or the given percentage of operations (at least one). The remaining operations are split into the given number of waves of equal size.
+// The waves are not stored, after a restart they are computed again from the operations which are not finished yet.
+func CanaryWaves(operations []orchestration.RuntimeOperation, spec orchestration.CanaryStrategySpec) [][]orchestration.RuntimeOperation {
+	var canary, remaining []orchestration.RuntimeOperation
+	if len(spec.RuntimeIDs) > 0 {
+		for _, op := range operations {
+			if slices.Contains(spec.RuntimeIDs, op.RuntimeID) {
+				canary = append(canary, op)
+			} else {
+				remaining = append(remaining, op)
+			}
+		}
+	} else {
+		size := int(math.Ceil(float64(len(operations)) * float64(spec.Percentage) / 100))
+		canary, remaining = operations[:size], operations[size:]
+	}
+
+	waves := make([][]orchestration.RuntimeOperation, 0)
+	if len(canary) > 0 {
+		waves = append(waves, canary)
+	}
+	count := spec.Waves
+	if count <= 0 {
+		count = 1
+	}
+	if count > len(remaining) {
+		count = len(remaining)
+	}
+	for i := 0; i < count; i++ {
+		begin, end := i*len(remaining)/count, (i+1)*len(remaining)/count
+		waves = append(waves, remaining[begin:end])
+	}
+	return waves
+}
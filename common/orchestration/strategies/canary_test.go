@@ -0,0 +1,173 @@
+package strategies
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+type canaryTestExecutor struct {
+	mux      sync.Mutex
+	executed []string
+	failed   map[string]bool
+}
+
+func (c *canaryTestExecutor) Execute(opID string) (time.Duration, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.executed = append(c.executed, opID)
+	return 0, nil
+}
+
+func (c *canaryTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (c *canaryTestExecutor) OperationState(operationID string) (string, error) {
+	if c.failed[operationID] {
+		return orchestration.Failed, nil
+	}
+	return orchestration.Succeeded, nil
+}
+
+func (c *canaryTestExecutor) executedCount() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.executed)
+}
+
+func canaryTestOperations(n int) []orchestration.RuntimeOperation {
+	ops := make([]orchestration.RuntimeOperation, n)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+			Runtime: orchestration.Runtime{
+				RuntimeID: rand.String(5),
+			},
+		}
+	}
+	return ops
+}
+
+func TestCanaryWaves(t *testing.T) {
+	ops := canaryTestOperations(10)
+
+	t.Run("should split operations by percentage", func(t *testing.T) {
+		// when
+		waves := CanaryWaves(ops, orchestration.CanaryStrategySpec{Percentage: 15, Waves: 3})
+
+		// then
+		require.Len(t, waves, 4)
+		assert.Len(t, waves[0], 2)
+		assert.Len(t, waves[1], 2)
+		assert.Len(t, waves[2], 3)
+		assert.Len(t, waves[3], 3)
+	})
+
+	t.Run("should select canary wave by runtime IDs", func(t *testing.T) {
+		// when
+		waves := CanaryWaves(ops, orchestration.CanaryStrategySpec{RuntimeIDs: []string{ops[3].RuntimeID, ops[7].RuntimeID}})
+
+		// then
+		require.Len(t, waves, 2)
+		assert.Equal(t, []orchestration.RuntimeOperation{ops[3], ops[7]}, waves[0])
+		assert.Len(t, waves[1], 8)
+	})
+
+	t.Run("should not create empty waves", func(t *testing.T) {
+		// when
+		waves := CanaryWaves(ops[:3], orchestration.CanaryStrategySpec{Percentage: 50, Waves: 5})
+
+		// then
+		require.Len(t, waves, 2)
+		assert.Len(t, waves[0], 2)
+		assert.Len(t, waves[1], 1)
+	})
+}
+
+func TestCanaryOrchestrationStrategy_AllWaves(t *testing.T) {
+	// given
+	executor := &canaryTestExecutor{}
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := canaryTestOperations(6)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+		Canary:   &orchestration.CanaryStrategySpec{Percentage: 20, Waves: 2, SoakPeriod: "10ms"},
+	})
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Equal(t, 6, executor.executedCount())
+	assert.NoError(t, s.(orchestration.HaltingStrategy).Halted(id))
+}
+
+func TestCanaryOrchestrationStrategy_FailureThresholdExceeded(t *testing.T) {
+	// given
+	ops := canaryTestOperations(6)
+	executor := &canaryTestExecutor{failed: map[string]bool{ops[0].ID: true}}
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+		Canary:   &orchestration.CanaryStrategySpec{Percentage: 30, FailureThreshold: 10},
+	})
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Equal(t, 2, executor.executedCount())
+	assert.ErrorContains(t, s.(orchestration.HaltingStrategy).Halted(id), "1 of 2 operations failed in wave 1")
+}
+
+func TestCanaryOrchestrationStrategy_Cancel(t *testing.T) {
+	// given
+	executor := &canaryTestExecutor{}
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := canaryTestOperations(4)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+		Canary:   &orchestration.CanaryStrategySpec{Percentage: 25, SoakPeriod: "1h"},
+	})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return executor.executedCount() == 1 }, time.Second, 10*time.Millisecond)
+	s.Cancel(id)
+
+	// then
+	s.Wait(id)
+	assert.Equal(t, 1, executor.executedCount())
+	assert.NoError(t, s.(orchestration.HaltingStrategy).Halted(id))
+}
+
+func TestCanaryOrchestrationStrategy_InvalidSpec(t *testing.T) {
+	// given
+	executor := &canaryTestExecutor{}
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+
+	// when
+	_, err := s.Execute(canaryTestOperations(2), orchestration.StrategySpec{
+		Type:   orchestration.CanaryStrategy,
+		Canary: &orchestration.CanaryStrategySpec{Percentage: 120},
+	})
+
+	// then
+	assert.Error(t, err)
+}
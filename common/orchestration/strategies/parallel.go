@@ -17,6 +17,7 @@ type ParallelOrchestrationStrategy struct {
 	dq              map[string]workqueue.DelayingInterface // scheduling queue, delaying queue for all pending & in progress ops
 	pq              map[string]workqueue.DelayingInterface // processing queue, delaying queue for the in progress ops
 	wg              map[string]*sync.WaitGroup
+	paused          map[string]chan struct{} // closed when the paused execution is resumed
 	mux             sync.RWMutex
 	log             logrus.FieldLogger
 	rescheduleDelay time.Duration
@@ -32,6 +33,7 @@ func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor,
 		dq:              map[string]workqueue.DelayingInterface{},
 		pq:              map[string]workqueue.DelayingInterface{},
 		wg:              map[string]*sync.WaitGroup{},
+		paused:          map[string]chan struct{}{},
 		log:             log,
 		rescheduleDelay: rescheduleDelay,
 		scheduleNum:     map[string]int{},
@@ -134,6 +136,13 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 
 		op := item.(*orchestration.RuntimeOperation)
 
+		// hold the operation while the execution is paused
+		p.waitIfPaused(execID)
+		if dq.ShuttingDown() {
+			dq.Done(item)
+			break
+		}
+
 		// check the window before process for the case if op Get is not in time
 		duration, err := p.updateMaintenanceWindow(execID, op, strategy)
 		if err != nil {
@@ -163,11 +172,16 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 }
 
 func (p *ParallelOrchestrationStrategy) processOperation(execID string) {
+	p.mux.RLock()
+	dq := p.dq[execID]
+	pq := p.pq[execID]
+	p.mux.RUnlock()
+
 	exit := false
 
 	for !exit {
 		exit = func() bool {
-			item, quit := p.pq[execID].Get()
+			item, quit := pq.Get()
 			if quit {
 				p.log.Infof("processing queue is shutdown")
 				return true
@@ -181,13 +195,13 @@ func (p *ParallelOrchestrationStrategy) processOperation(execID string) {
 				if err := recover(); err != nil {
 					log.Errorf("panic error from process: %v. Stacktrace: %s", err, debug.Stack())
 				}
-				p.pq[execID].Done(item)
+				pq.Done(item)
 			}()
 
 			when, err := p.executor.Execute(id)
 			if err == nil && when != 0 {
 				log.Infof("Adding %q item after %v", id, when)
-				p.pq[execID].AddAfter(item, time.Duration(int64(when)/int64(p.speedFactor)))
+				pq.AddAfter(item, time.Duration(int64(when)/int64(p.speedFactor)))
 				return false
 			}
 			if err != nil {
@@ -195,7 +209,7 @@ func (p *ParallelOrchestrationStrategy) processOperation(execID string) {
 			}
 
 			log.Infof("Finishing processing operation")
-			p.dq[execID].Done(item)
+			dq.Done(item)
 
 			return true
 		}()
@@ -263,6 +277,46 @@ func (p *ParallelOrchestrationStrategy) Cancel(executionID string) {
 	if pq != nil {
 		pq.ShutDown()
 	}
+
+	// release workers waiting for the paused execution
+	if resumed, paused := p.paused[executionID]; paused {
+		close(resumed)
+		delete(p.paused, executionID)
+	}
+}
+
+// Pause stops scheduling operations of the execution, operations which are already processed are finished
+func (p *ParallelOrchestrationStrategy) Pause(executionID string) {
+	if executionID == "" {
+		return
+	}
+	p.log.Infof("Pausing strategy execution %s", executionID)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if _, paused := p.paused[executionID]; !paused {
+		p.paused[executionID] = make(chan struct{})
+	}
+}
+
+// Resume continues scheduling operations of the paused execution
+func (p *ParallelOrchestrationStrategy) Resume(executionID string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if resumed, paused := p.paused[executionID]; paused {
+		p.log.Infof("Resuming strategy execution %s", executionID)
+		close(resumed)
+		delete(p.paused, executionID)
+	}
+}
+
+func (p *ParallelOrchestrationStrategy) waitIfPaused(executionID string) {
+	p.mux.RLock()
+	resumed, paused := p.paused[executionID]
+	p.mux.RUnlock()
+	if paused {
+		<-resumed
+	}
 }
 
 func (p *ParallelOrchestrationStrategy) handleRescheduleErrorOperation(execID string, op *orchestration.RuntimeOperation) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
@@ -106,3 +107,35 @@ func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	assert.NoError(t, err)
 	s.Wait(id)
 }
+
+func TestNewParallelOrchestrationStrategy_PauseAndResume(t *testing.T) {
+	// given
+	executor := &testExecutor{opCalled: map[string]bool{}}
+	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	pausable := s.(orchestration.PausableStrategy)
+
+	ops := make([]orchestration.RuntimeOperation, 3)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{Schedule: time.Now().Add(time.Second).Format(time.RFC3339), ScheduleTime: time.Now().Add(time.Second), Parallel: orchestration.ParallelStrategySpec{Workers: 3}})
+	require.NoError(t, err)
+	pausable.Pause(id)
+	time.Sleep(2 * time.Second)
+
+	// then
+	executor.mux.Lock()
+	assert.Empty(t, executor.opCalled)
+	executor.mux.Unlock()
+
+	// when
+	pausable.Resume(id)
+
+	// then
+	s.Wait(id)
+	assert.Len(t, executor.opCalled, 3)
+}
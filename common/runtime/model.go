@@ -48,6 +48,7 @@ type RuntimeDTO struct {
 	ServicePlanID               string                         `json:"servicePlanID"`
 	ServicePlanName             string                         `json:"servicePlanName"`
 	Provider                    string                         `json:"provider"`
+	LicenseType                 string                         `json:"licenseType,omitempty"`
 	Status                      RuntimeStatus                  `json:"status"`
 	UserID                      string                         `json:"userID"`
 	AVSInternalEvaluationID     int64                          `json:"avsInternalEvaluationID"`
@@ -65,6 +66,7 @@ type RuntimeStatus struct {
 	Provisioning     *Operation                `json:"provisioning,omitempty"`
 	Deprovisioning   *Operation                `json:"deprovisioning,omitempty"`
 	UpgradingCluster *OperationsData           `json:"upgradingCluster,omitempty"`
+	UpgradingKyma    *OperationsData           `json:"upgradingKyma,omitempty"`
 	Update           *OperationsData           `json:"update,omitempty"`
 	Suspension       *OperationsData           `json:"suspension,omitempty"`
 	Unsuspension     *OperationsData           `json:"unsuspension,omitempty"`
@@ -77,6 +79,7 @@ const (
 	Provision      OperationType = "provision"
 	Deprovision    OperationType = "deprovision"
 	UpgradeCluster OperationType = "cluster upgrade"
+	UpgradeKyma    OperationType = "kyma upgrade"
 	Update         OperationType = "update"
 	Suspension     OperationType = "suspension"
 	Unsuspension   OperationType = "unsuspension"
@@ -106,6 +109,22 @@ type RuntimesPage struct {
 	TotalCount int          `json:"totalCount"`
 }
 
+type BindingDTO struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Role           string    `json:"role"`
+	Namespace      string    `json:"namespace,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	OperationType  string    `json:"operationType"`
+	OperationState string    `json:"operationState"`
+}
+
+type BindingsPage struct {
+	Data  []BindingDTO `json:"data"`
+	Count int          `json:"count"`
+}
+
 const (
 	GlobalAccountIDParam = "account"
 	SubAccountIDParam    = "subaccount"
@@ -203,5 +222,11 @@ func (rt RuntimeDTO) LastOperation() Operation {
 		op.Type = Update
 	}
 
+	// Take the first kyma upgrade operation, assuming that Data is sorted by CreatedAt DESC.
+	if rt.Status.UpgradingKyma != nil && rt.Status.UpgradingKyma.Count > 0 && rt.Status.UpgradingKyma.Data[0].CreatedAt.After(op.CreatedAt) {
+		op = rt.Status.UpgradingKyma.Data[0]
+		op.Type = UpgradeKyma
+	}
+
 	return op
 }
@@ -157,7 +157,7 @@ func (h *RuntimeInfoHandler) planNameOrDefault(inst internal.InstanceWithOperati
 	if inst.ServicePlanName != "" {
 		return inst.ServicePlanName
 	}
-	return broker.PlanNamesMapping[inst.ServicePlanID]
+	return broker.PlanNameForID(inst.ServicePlanID)
 }
 
 func getIfNotZero(in time.Time) *time.Time {
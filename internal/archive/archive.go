@@ -42,7 +42,7 @@ func NewInstanceArchivedFromOperations(operations []internal.Operation) (interna
 	result.ProvisioningFinishedAt = provisioningOperation.UpdatedAt
 	result.ProvisioningState = provisioningOperation.State
 	result.PlanID = provisioningOperation.ProvisioningParameters.PlanID
-	result.PlanName = broker.PlanNamesMapping[result.PlanID]
+	result.PlanName = broker.PlanNameForID(result.PlanID)
 	result.InstanceID = provisioningOperation.InstanceID
 	result.GlobalAccountID = provisioningOperation.ProvisioningParameters.ErsContext.GlobalAccountID
 	result.SubaccountID = provisioningOperation.ProvisioningParameters.ErsContext.SubAccountID
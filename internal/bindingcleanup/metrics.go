@@ -0,0 +1,46 @@
+package bindingcleanup
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	metricsNamespace = "kcp"
+	metricsSubsystem = "keb_binding_cleanup"
+)
+
+type Metrics struct {
+	expired  *prometheus.CounterVec
+	removed  *prometheus.CounterVec
+	skipped  prometheus.Counter
+	failures prometheus.Counter
+}
+
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		expired: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "expired_bindings_total",
+			Help:      "Number of expired bindings found in the database.",
+		}, []string{"binding_type"}),
+		removed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "removed_bindings_total",
+			Help:      "Number of expired bindings removed from the Kyma runtimes and the database.",
+		}, []string{"binding_type"}),
+		skipped: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "skipped_bindings_total",
+			Help:      "Number of expired bindings skipped because of a binding operation in progress.",
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "failures_total",
+			Help:      "Number of expired bindings which could not be removed.",
+		}),
+	}
+	reg.MustRegister(m.expired, m.removed, m.skipped, m.failures)
+	return m
+}
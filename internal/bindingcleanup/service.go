@@ -0,0 +1,104 @@
+package bindingcleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	broker "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// Service removes expired bindings. For bindings of the service_account type the ServiceAccount and its role
+// and role binding are removed from the Kyma runtime, kubeconfigs generated with the adminkubeconfig
+// subresource cannot be revoked, so only the database entry is removed for them.
+type Service struct {
+	dryRun                        bool
+	bindings                      storage.Bindings
+	instances                     storage.Instances
+	serviceAccountBindingsManager broker.BindingsManager
+	metrics                       *Metrics
+	log                           logrus.FieldLogger
+}
+
+func NewService(dryRun bool, bindings storage.Bindings, instances storage.Instances, serviceAccountBindingsManager broker.BindingsManager, metrics *Metrics, log logrus.FieldLogger) *Service {
+	return &Service{
+		dryRun:                        dryRun,
+		bindings:                      bindings,
+		instances:                     instances,
+		serviceAccountBindingsManager: serviceAccountBindingsManager,
+		metrics:                       metrics,
+		log:                           log,
+	}
+}
+
+func (s *Service) PerformCleanup() error {
+	expiredBindings, err := s.bindings.ListExpired()
+	if err != nil {
+		return fmt.Errorf("while listing expired bindings: %w", err)
+	}
+	s.log.Infof("Expired bindings: %d", len(expiredBindings))
+
+	removed, skipped, failures := 0, 0, 0
+	for _, binding := range expiredBindings {
+		log := s.log.WithFields(logrus.Fields{"bindingID": binding.ID, "instanceID": binding.InstanceID, "bindingType": binding.BindingType})
+		s.metrics.expired.WithLabelValues(binding.BindingType).Inc()
+
+		if binding.OperationState == domain.InProgress {
+			log.Infof("%s operation in progress, skipping", binding.OperationType)
+			s.metrics.skipped.Inc()
+			skipped++
+			continue
+		}
+
+		if s.dryRun {
+			log.Infof("binding expired at %s, would be removed", binding.ExpiresAt)
+			continue
+		}
+
+		err := s.removeBinding(binding, log)
+		if err != nil {
+			// ignoring errors - only logging, the binding is processed again in the next run
+			log.Errorf("while removing expired binding: %s", err)
+			s.metrics.failures.Inc()
+			failures++
+			continue
+		}
+		s.metrics.removed.WithLabelValues(binding.BindingType).Inc()
+		removed++
+	}
+
+	if s.dryRun {
+		s.log.Infof("Dry run - expired bindings: %d, skipped: %d", len(expiredBindings), skipped)
+	} else {
+		s.log.Infof("Expired bindings: %d, removed: %d, skipped: %d, failures: %d", len(expiredBindings), removed, skipped, failures)
+	}
+	return nil
+}
+
+func (s *Service) removeBinding(binding internal.Binding, log logrus.FieldLogger) error {
+	if binding.BindingType == internal.BINDING_TYPE_SERVICE_ACCOUNT {
+		instance, err := s.instances.GetByID(binding.InstanceID)
+		switch {
+		case dberr.IsNotFound(err):
+			log.Infof("instance does not exist, removing binding from the database only")
+		case err != nil:
+			return fmt.Errorf("while getting instance: %w", err)
+		default:
+			err = s.serviceAccountBindingsManager.Delete(context.Background(), instance, &binding)
+			if err != nil {
+				return fmt.Errorf("while removing service account resources from the runtime: %w", err)
+			}
+		}
+	}
+
+	err := s.bindings.DeleteByBindingID(binding.ID)
+	if err != nil {
+		return fmt.Errorf("while removing binding from the database: %w", err)
+	}
+	log.Infof("binding expired at %s, removed", binding.ExpiresAt)
+	return nil
+}
@@ -0,0 +1,136 @@
+package bindingcleanup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const instanceID = "instance-id"
+
+func TestService_PerformCleanup(t *testing.T) {
+	t.Run("should remove expired bindings", func(t *testing.T) {
+		// given
+		db := fixStorage(t)
+		manager := &fakeBindingsManager{}
+		metrics := NewMetrics(prometheus.NewRegistry())
+		svc := NewService(false, db.Bindings(), db.Instances(), manager, metrics, logrus.New())
+
+		// when
+		err := svc.PerformCleanup()
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"expired-sa"}, manager.deleted)
+		assertBindingsExist(t, db, "valid", "in-progress")
+		assertBindingsRemoved(t, db, "expired-sa", "expired-admin")
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.removed.WithLabelValues(internal.BINDING_TYPE_SERVICE_ACCOUNT)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.removed.WithLabelValues(internal.BINDING_TYPE_ADMIN_KUBECONFIG)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.skipped))
+		assert.Equal(t, float64(0), testutil.ToFloat64(metrics.failures))
+	})
+
+	t.Run("should not remove bindings in dry run", func(t *testing.T) {
+		// given
+		db := fixStorage(t)
+		manager := &fakeBindingsManager{}
+		metrics := NewMetrics(prometheus.NewRegistry())
+		svc := NewService(true, db.Bindings(), db.Instances(), manager, metrics, logrus.New())
+
+		// when
+		err := svc.PerformCleanup()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, manager.deleted)
+		assertBindingsExist(t, db, "valid", "in-progress", "expired-sa", "expired-admin")
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.expired.WithLabelValues(internal.BINDING_TYPE_ADMIN_KUBECONFIG)))
+		assert.Equal(t, float64(2), testutil.ToFloat64(metrics.expired.WithLabelValues(internal.BINDING_TYPE_SERVICE_ACCOUNT)))
+		assert.Equal(t, float64(0), testutil.ToFloat64(metrics.removed.WithLabelValues(internal.BINDING_TYPE_SERVICE_ACCOUNT)))
+	})
+
+	t.Run("should keep binding when runtime resources cannot be removed", func(t *testing.T) {
+		// given
+		db := fixStorage(t)
+		manager := &fakeBindingsManager{err: fmt.Errorf("runtime not reachable")}
+		metrics := NewMetrics(prometheus.NewRegistry())
+		svc := NewService(false, db.Bindings(), db.Instances(), manager, metrics, logrus.New())
+
+		// when
+		err := svc.PerformCleanup()
+
+		// then
+		require.NoError(t, err)
+		assertBindingsExist(t, db, "expired-sa")
+		assertBindingsRemoved(t, db, "expired-admin")
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.failures))
+	})
+}
+
+func fixStorage(t *testing.T) storage.BrokerStorage {
+	db := storage.NewMemoryStorage()
+	err := db.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+
+	for _, binding := range []internal.Binding{
+		fixBinding("valid", internal.BINDING_TYPE_SERVICE_ACCOUNT, time.Now().Add(time.Hour), domain.Succeeded),
+		fixBinding("in-progress", internal.BINDING_TYPE_SERVICE_ACCOUNT, time.Now().Add(-time.Hour), domain.InProgress),
+		fixBinding("expired-sa", internal.BINDING_TYPE_SERVICE_ACCOUNT, time.Now().Add(-time.Hour), domain.Succeeded),
+		fixBinding("expired-admin", internal.BINDING_TYPE_ADMIN_KUBECONFIG, time.Now().Add(-time.Hour), domain.Succeeded),
+	} {
+		err = db.Bindings().Insert(&binding)
+		require.NoError(t, err)
+	}
+	return db
+}
+
+func fixBinding(id, bindingType string, expiresAt time.Time, state domain.LastOperationState) internal.Binding {
+	binding := fixture.FixBindingWithInstanceID(id, instanceID)
+	binding.BindingType = bindingType
+	binding.ExpiresAt = expiresAt
+	binding.OperationState = state
+	return binding
+}
+
+func assertBindingsExist(t *testing.T, db storage.BrokerStorage, ids ...string) {
+	for _, id := range ids {
+		_, err := db.Bindings().GetByBindingID(id)
+		assert.NoError(t, err, "binding %s should exist", id)
+	}
+}
+
+func assertBindingsRemoved(t *testing.T, db storage.BrokerStorage, ids ...string) {
+	for _, id := range ids {
+		_, err := db.Bindings().GetByBindingID(id)
+		assert.True(t, dberr.IsNotFound(err), "binding %s should be removed", id)
+	}
+}
+
+type fakeBindingsManager struct {
+	err     error
+	deleted []string
+}
+
+func (m *fakeBindingsManager) Create(_ context.Context, _ *internal.Instance, _ *internal.Binding) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+func (m *fakeBindingsManager) Delete(_ context.Context, _ *internal.Instance, binding *internal.Binding) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.deleted = append(m.deleted, binding.ID)
+	return nil
+}
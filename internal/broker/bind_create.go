@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/kyma-project/kyma-environment-broker/internal"
 	broker "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
@@ -15,6 +18,7 @@ import (
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -24,44 +28,49 @@ type BindingConfig struct {
 	ExpirationSeconds    int         `envconfig:"default=600"`
 	MaxExpirationSeconds int         `envconfig:"default=7200"`
 	MinExpirationSeconds int         `envconfig:"default=600"`
+	// AllowedRoles lists the role profiles which can be requested with the role parameter
+	AllowedRoles []string `envconfig:"default=cluster-admin"`
+	// MaxBindingsCount limits the number of not expired bindings of an instance, 0 means no limit
+	MaxBindingsCount int `envconfig:"default=0"`
+
+	// AsyncOperationsEnabled enables asynchronous processing of bind and unbind requests when the platform accepts incomplete responses
+	AsyncOperationsEnabled bool          `envconfig:"default=false"`
+	OperationTimeout       time.Duration `envconfig:"default=15m"`
+	WorkersAmount          int           `envconfig:"default=5"`
 }
 
 type BindEndpoint struct {
 	config           BindingConfig
 	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
 
 	serviceAccountBindingManager broker.BindingsManager
 	gardenerBindingsManager      broker.BindingsManager
 
+	queue Queue
+
 	log logrus.FieldLogger
 }
 
 type BindingParams struct {
-	ServiceAccount    bool `json:"service_account,omit"`
-	ExpirationSeconds int  `json:"expiration_seconds,omit"`
+	ServiceAccount    bool   `json:"service_account,omit"`
+	ExpirationSeconds int    `json:"expiration_seconds,omit"`
+	Role              string `json:"role,omit"`
+	Namespace         string `json:"namespace,omit"`
 }
 
 type Credentials struct {
 	Kubeconfig string `json:"kubeconfig"`
 }
 
-func NewBind(cfg BindingConfig, instanceStorage storage.Instances, log logrus.FieldLogger, clientProvider broker.ClientProvider, kubeconfigProvider broker.KubeconfigProvider, gardenerClient client.Client) *BindEndpoint {
-	return &BindEndpoint{config: cfg, instancesStorage: instanceStorage, log: log.WithField("service", "BindEndpoint"),
+func NewBind(cfg BindingConfig, instanceStorage storage.Instances, bindingsStorage storage.Bindings, log logrus.FieldLogger, clientProvider broker.ClientProvider, kubeconfigProvider broker.KubeconfigProvider, gardenerClient client.Client, queue Queue) *BindEndpoint {
+	return &BindEndpoint{config: cfg, instancesStorage: instanceStorage, bindingsStorage: bindingsStorage, log: log.WithField("service", "BindEndpoint"),
 		serviceAccountBindingManager: broker.NewServiceAccountBindingsManager(clientProvider, kubeconfigProvider),
 		gardenerBindingsManager:      broker.NewGardenerBindingManager(gardenerClient),
+		queue:                        queue,
 	}
 }
 
-type BindingData struct {
-	Username string
-	Password string
-}
-
-var dummyCredentials = BindingData{
-	Username: "admin",
-	Password: "admin1234",
-}
-
 // Bind creates a new service binding
 //
 //	PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}
@@ -111,30 +120,230 @@ func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, d
 		expirationSeconds = parameters.ExpirationSeconds
 	}
 
-	var kubeconfig string
+	bindingType := internal.BINDING_TYPE_ADMIN_KUBECONFIG
+	if parameters.ServiceAccount {
+		bindingType = internal.BINDING_TYPE_SERVICE_ACCOUNT
+	}
+
+	role, err := b.validateRole(parameters)
+	if err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+
+	existingBinding, err := b.bindingsStorage.GetByBindingID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+	case err != nil:
+		message := fmt.Sprintf("failed to get binding %s from storage: %s", bindingID, err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	case existingBinding.InstanceID != instanceID || existingBinding.BindingType != bindingType || existingBinding.ExpirationSeconds != int64(expirationSeconds) ||
+		existingBinding.Role != role || existingBinding.Namespace != parameters.Namespace:
+		return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+	case existingBinding.OperationType == internal.BindingOperationTypeUnbind:
+		return domain.Binding{}, apiresponses.ErrConcurrentInstanceAccess
+	case existingBinding.OperationState == domain.InProgress:
+		if !asyncAllowed {
+			return domain.Binding{}, apiresponses.ErrAsyncRequired
+		}
+		return domain.Binding{
+			IsAsync:       true,
+			OperationData: string(internal.BindingOperationTypeBind),
+		}, nil
+	case existingBinding.OperationState == domain.Failed:
+		b.log.Infof("previous attempt to create binding %s failed, starting a new one", bindingID)
+		err = b.bindingsStorage.DeleteByBindingID(bindingID)
+		if err != nil {
+			message := fmt.Sprintf("failed to delete failed binding %s from storage: %s", bindingID, err)
+			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+		}
+	default:
+		b.log.Infof("binding %s already exists for instance %s, returning stored credentials", bindingID, instanceID)
+		return domain.Binding{
+			IsAsync:       false,
+			AlreadyExists: true,
+			Credentials: Credentials{
+				Kubeconfig: existingBinding.Kubeconfig,
+			},
+		}, nil
+	}
+
+	if b.config.MaxBindingsCount > 0 {
+		activeBindings, err := b.listActiveBindings(instanceID)
+		if err != nil {
+			message := fmt.Sprintf("failed to count bindings of instance %s: %s", instanceID, err)
+			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+		}
+		if len(activeBindings) >= b.config.MaxBindingsCount {
+			message := fmt.Sprintf("maximum number of non-expired bindings reached: %d", b.config.MaxBindingsCount)
+			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusBadRequest, message)
+		}
+	}
+
+	// the binding is stored before the credentials are created, so that concurrent requests see it when counting bindings
+	now := time.Now()
+	binding := &internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		ExpiresAt:         now.Add(time.Duration(expirationSeconds) * time.Second),
+		ExpirationSeconds: int64(expirationSeconds),
+		BindingType:       bindingType,
+		Role:              role,
+		Namespace:         parameters.Namespace,
+
+		OperationType:        internal.BindingOperationTypeBind,
+		OperationState:       domain.InProgress,
+		OperationDescription: "binding in progress",
+	}
+	err = b.bindingsStorage.Insert(binding)
+	if err != nil {
+		message := fmt.Sprintf("failed to store binding %s: %s", bindingID, err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	}
+
+	if b.config.MaxBindingsCount > 0 {
+		err = b.checkBindingsLimit(binding)
+		if err != nil {
+			b.removeBinding(bindingID)
+			return domain.Binding{}, err
+		}
+	}
+
+	if b.config.AsyncOperationsEnabled && asyncAllowed {
+		b.log.Infof("Adding binding %s to the binding queue", bindingID)
+		b.queue.Add(bindingID)
+
+		return domain.Binding{
+			IsAsync:       true,
+			OperationData: string(internal.BindingOperationTypeBind),
+		}, nil
+	}
+
 	if parameters.ServiceAccount {
 		// get kubeconfig for the instance
-		kubeconfig, err = b.serviceAccountBindingManager.Create(ctx, instance, bindingID, expirationSeconds)
+		binding.Kubeconfig, err = b.serviceAccountBindingManager.Create(ctx, instance, binding)
 		if err != nil {
+			b.removeBinding(bindingID)
 			message := fmt.Sprintf("failed to create kyma binding for service account using token request: %s", err)
 			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusBadRequest, message)
 		}
 	} else {
-		kubeconfig, err = b.gardenerBindingsManager.Create(ctx, instance, bindingID, expirationSeconds)
+		binding.Kubeconfig, err = b.gardenerBindingsManager.Create(ctx, instance, binding)
 		if err != nil {
+			b.removeBinding(bindingID)
 			message := fmt.Sprintf("failed to create kyma binding using adminkubeconfig gardener subresource: %s", err)
 			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusBadRequest, message)
 		}
 	}
 
+	binding.UpdatedAt = time.Now()
+	binding.OperationState = domain.Succeeded
+	binding.OperationDescription = "binding created"
+	err = b.bindingsStorage.Update(binding)
+	if err != nil {
+		message := fmt.Sprintf("failed to store binding %s: %s", bindingID, err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	}
+
 	return domain.Binding{
 		IsAsync: false,
 		Credentials: Credentials{
-			Kubeconfig: kubeconfig,
+			Kubeconfig: binding.Kubeconfig,
 		},
 	}, nil
 }
 
+// checkBindingsLimit recounts the bindings after the given one was stored. Bindings are ordered by the creation time,
+// the binding is rejected when it is not among the first allowed ones, so concurrent requests cannot exceed the limit.
+func (b *BindEndpoint) checkBindingsLimit(binding *internal.Binding) error {
+	activeBindings, err := b.listActiveBindings(binding.InstanceID)
+	if err != nil {
+		message := fmt.Sprintf("failed to count bindings of instance %s: %s", binding.InstanceID, err)
+		return apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	}
+	if len(activeBindings) <= b.config.MaxBindingsCount {
+		return nil
+	}
+
+	slices.SortFunc(activeBindings, func(a, b internal.Binding) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	for _, active := range activeBindings[:b.config.MaxBindingsCount] {
+		if active.ID == binding.ID {
+			return nil
+		}
+	}
+
+	message := fmt.Sprintf("maximum number of non-expired bindings reached: %d", b.config.MaxBindingsCount)
+	return apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusBadRequest, message)
+}
+
+func (b *BindEndpoint) removeBinding(bindingID string) {
+	if err := b.bindingsStorage.DeleteByBindingID(bindingID); err != nil {
+		b.log.Errorf("failed to delete binding %s from storage: %s", bindingID, err)
+	}
+}
+
+// listActiveBindings returns bindings which are not expired and whose creation did not fail
+func (b *BindEndpoint) listActiveBindings(instanceID string) ([]internal.Binding, error) {
+	bindings, err := b.bindingsStorage.ListByInstanceID(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []internal.Binding
+	now := time.Now()
+	for _, binding := range bindings {
+		if binding.ExpiresAt.After(now) && binding.OperationState != domain.Failed {
+			active = append(active, binding)
+		}
+	}
+	return active, nil
+}
+
+// validateRole returns the role profile requested with the binding parameters. The adminkubeconfig subresource
+// always grants the cluster-admin role, other roles and the namespace can be requested only for service account bindings.
+func (b *BindEndpoint) validateRole(parameters BindingParams) (string, error) {
+	role := internal.BINDING_ROLE_CLUSTER_ADMIN
+	if parameters.Role != "" {
+		role = parameters.Role
+	}
+
+	if !parameters.ServiceAccount && (parameters.Role != "" || parameters.Namespace != "") {
+		return "", fmt.Errorf("role and namespace can be set only when service_account is true")
+	}
+
+	if !slices.Contains(b.config.AllowedRoles, role) {
+		return "", fmt.Errorf("role %s is not allowed, allowed roles: %s", role, strings.Join(b.config.AllowedRoles, ", "))
+	}
+
+	switch role {
+	case internal.BINDING_ROLE_CLUSTER_ADMIN:
+		if parameters.Namespace != "" {
+			return "", fmt.Errorf("namespace cannot be set for the %s role", role)
+		}
+	case internal.BINDING_ROLE_NAMESPACE_ADMIN:
+		if parameters.Namespace == "" {
+			return "", fmt.Errorf("namespace must be set for the %s role", role)
+		}
+	case internal.BINDING_ROLE_READ_ONLY:
+	default:
+		return "", fmt.Errorf("unknown role %s", role)
+	}
+
+	if parameters.Namespace != "" {
+		if errs := validation.IsDNS1123Label(parameters.Namespace); len(errs) != 0 {
+			return "", fmt.Errorf("invalid namespace %s: %s", parameters.Namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	return role, nil
+}
+
 func (b *BindEndpoint) IsPlanBindable(planName string) bool {
 	planNameLowerCase := strings.ToLower(planName)
 	for _, p := range b.config.BindablePlans {
@@ -17,15 +17,21 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker/automock"
 	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/kyma-environment-broker/internal/kubeconfig"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/pivotal-cf/brokerapi/v8/handlers"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -158,10 +164,12 @@ func TestCreateBindingEndpoint(t *testing.T) {
 		ExpirationSeconds:    expirationSeconds,
 		MaxExpirationSeconds: maxExpirationSeconds,
 		MinExpirationSeconds: minExpirationSeconds,
+		AllowedRoles:         []string{internal.BINDING_ROLE_CLUSTER_ADMIN, internal.BINDING_ROLE_NAMESPACE_ADMIN, internal.BINDING_ROLE_READ_ONLY},
 	}
 
 	//// api handler
-	bindEndpoint := NewBind(*bindingCfg, db.Instances(), logs, skrK8sClientProvider, skrK8sClientProvider, gardenerClient)
+	bindEndpoint := NewBind(*bindingCfg, db.Instances(), db.Bindings(), logs, skrK8sClientProvider, skrK8sClientProvider, gardenerClient, nil)
+	unbindEndpoint := NewUnbind(*bindingCfg, logs, db.Instances(), db.Bindings(), skrK8sClientProvider, skrK8sClientProvider, gardenerClient, nil)
 	apiHandler := handlers.NewApiHandler(KymaEnvironmentBroker{
 		nil,
 		nil,
@@ -170,7 +178,7 @@ func TestCreateBindingEndpoint(t *testing.T) {
 		nil,
 		nil,
 		bindEndpoint,
-		nil,
+		unbindEndpoint,
 		nil,
 		nil,
 	}, brokerLogger)
@@ -179,6 +187,7 @@ func TestCreateBindingEndpoint(t *testing.T) {
 	method := "PUT"
 	router := mux.NewRouter()
 	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", apiHandler.Bind).Methods(method)
+	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", apiHandler.Unbind).Methods("DELETE")
 	httpServer := httptest.NewServer(router)
 	defer httpServer.Close()
 
@@ -215,6 +224,40 @@ func TestCreateBindingEndpoint(t *testing.T) {
 		assert.NoError(t, err)
 		_, err = newClient.RbacV1().ClusterRoleBindings().Get(context.Background(), "kyma-binding-binding-id", v1.GetOptions{})
 		assert.NoError(t, err)
+
+		//// verify the binding is stored
+		storedBinding, err := db.Bindings().GetByBindingID("binding-id")
+		require.NoError(t, err)
+		assert.Equal(t, "1", storedBinding.InstanceID)
+		assert.Equal(t, internal.BINDING_TYPE_SERVICE_ACCOUNT, storedBinding.BindingType)
+		assert.Equal(t, kubeconfig, storedBinding.Kubeconfig)
+		assert.Equal(t, storedBinding.CreatedAt.Add(expirationSeconds*time.Second), storedBinding.ExpiresAt)
+	})
+
+	t.Run("should return the stored binding when the same binding is requested again", func(t *testing.T) {
+		body := fmt.Sprintf(`
+		{
+			"service_id": "123",
+			"plan_id": "%s",
+			"parameters": {
+				"service_account": true
+			}
+		}`, fixture.PlanId)
+		response := CallAPI(httpServer, method, "v2/service_instances/1/service_bindings/binding-id-retry?accepts_incomplete=true", body, t)
+		binding := verifyResponse(t, response)
+
+		// When
+		response = CallAPI(httpServer, method, "v2/service_instances/1/service_bindings/binding-id-retry?accepts_incomplete=true", body, t)
+
+		// Then
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		content, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		var retried domain.Binding
+		err = json.Unmarshal(content, &retried)
+		require.NoError(t, err)
+		assert.Equal(t, binding.Credentials, retried.Credentials)
 	})
 
 	t.Run("should create a new service binding with custom token expiration time", func(t *testing.T) {
@@ -240,6 +283,72 @@ func TestCreateBindingEndpoint(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, customExpirationSeconds*time.Second, duration)
 	})
+	t.Run("should delete the service binding and its service account resources", func(t *testing.T) {
+		// Given
+		response := CallAPI(httpServer, method, "v2/service_instances/1/service_bindings/binding-id-unbind?accepts_incomplete=true", fmt.Sprintf(`
+		{
+			"service_id": "123",
+			"plan_id": "%s",
+			"parameters": {
+				"service_account": true
+			}
+		}`, fixture.PlanId), t)
+		verifyResponse(t, response)
+
+		// When
+		response = CallAPI(httpServer, "DELETE", "v2/service_instances/1/service_bindings/binding-id-unbind?accepts_incomplete=true&service_id=123&plan_id="+fixture.PlanId, "", t)
+
+		// Then
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		_, err := db.Bindings().GetByBindingID("binding-id-unbind")
+		assert.True(t, dberr.IsNotFound(err))
+
+		err = skrClient.Get(context.Background(), client.ObjectKey{Namespace: "kyma-system", Name: "kyma-binding-binding-id-unbind"}, &corev1.ServiceAccount{})
+		assert.True(t, apierrors.IsNotFound(err))
+		err = skrClient.Get(context.Background(), client.ObjectKey{Name: "kyma-binding-binding-id-unbind"}, &rbacv1.ClusterRole{})
+		assert.True(t, apierrors.IsNotFound(err))
+		err = skrClient.Get(context.Background(), client.ObjectKey{Name: "kyma-binding-binding-id-unbind"}, &rbacv1.ClusterRoleBinding{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		// When
+		response = CallAPI(httpServer, "DELETE", "v2/service_instances/1/service_bindings/binding-id-unbind?accepts_incomplete=true&service_id=123&plan_id="+fixture.PlanId, "", t)
+
+		// Then
+		require.Equal(t, http.StatusGone, response.StatusCode)
+	})
+
+	t.Run("should create a service binding with the namespace-admin role", func(t *testing.T) {
+		// When
+		response := CallAPI(httpServer, method, "v2/service_instances/1/service_bindings/binding-id-ns?accepts_incomplete=true", fmt.Sprintf(`
+		{
+			"service_id": "123",
+			"plan_id": "%s",
+			"parameters": {
+				"service_account": true,
+				"role": "namespace-admin",
+				"namespace": "default"
+			}
+		}`, fixture.PlanId), t)
+
+		binding := verifyResponse(t, response)
+
+		credentials, ok := binding.Credentials.(map[string]interface{})
+		require.True(t, ok)
+
+		//// verify the kubeconfig grants access only to the given namespace
+		newClient := kubeconfigClient(t, credentials["kubeconfig"].(string))
+		_, err := newClient.CoreV1().Secrets("default").Get(context.Background(), "secret-to-check", v1.GetOptions{})
+		assert.NoError(t, err)
+		_, err = newClient.CoreV1().ServiceAccounts("kyma-system").List(context.Background(), v1.ListOptions{})
+		assert.True(t, apierrors.IsForbidden(err))
+
+		err = skrClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "kyma-binding-binding-id-ns"}, &rbacv1.RoleBinding{})
+		assert.NoError(t, err)
+		err = skrClient.Get(context.Background(), client.ObjectKey{Name: "kyma-binding-binding-id-ns"}, &rbacv1.ClusterRoleBinding{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
 	t.Run("should return error when expiration_seconds is greater than maxExpirationSeconds", func(t *testing.T) {
 		const customExpirationSeconds = 7201
 
@@ -274,6 +383,167 @@ func TestCreateBindingEndpoint(t *testing.T) {
 	})
 }
 
+func TestBindEndpoint_ValidateRole(t *testing.T) {
+	bindEndpoint := &BindEndpoint{config: BindingConfig{
+		AllowedRoles: []string{internal.BINDING_ROLE_CLUSTER_ADMIN, internal.BINDING_ROLE_NAMESPACE_ADMIN},
+	}}
+
+	for name, tc := range map[string]struct {
+		parameters   BindingParams
+		expectedRole string
+		expectedErr  bool
+	}{
+		"admin kubeconfig": {
+			parameters:   BindingParams{},
+			expectedRole: internal.BINDING_ROLE_CLUSTER_ADMIN,
+		},
+		"service account with default role": {
+			parameters:   BindingParams{ServiceAccount: true},
+			expectedRole: internal.BINDING_ROLE_CLUSTER_ADMIN,
+		},
+		"service account with namespace-admin role": {
+			parameters:   BindingParams{ServiceAccount: true, Role: internal.BINDING_ROLE_NAMESPACE_ADMIN, Namespace: "ci"},
+			expectedRole: internal.BINDING_ROLE_NAMESPACE_ADMIN,
+		},
+		"role not in the allow-list": {
+			parameters:  BindingParams{ServiceAccount: true, Role: internal.BINDING_ROLE_READ_ONLY},
+			expectedErr: true,
+		},
+		"unknown role": {
+			parameters:  BindingParams{ServiceAccount: true, Role: "superuser"},
+			expectedErr: true,
+		},
+		"role for admin kubeconfig": {
+			parameters:  BindingParams{Role: internal.BINDING_ROLE_NAMESPACE_ADMIN, Namespace: "ci"},
+			expectedErr: true,
+		},
+		"namespace-admin role without namespace": {
+			parameters:  BindingParams{ServiceAccount: true, Role: internal.BINDING_ROLE_NAMESPACE_ADMIN},
+			expectedErr: true,
+		},
+		"cluster-admin role with namespace": {
+			parameters:  BindingParams{ServiceAccount: true, Namespace: "ci"},
+			expectedErr: true,
+		},
+		"invalid namespace": {
+			parameters:  BindingParams{ServiceAccount: true, Role: internal.BINDING_ROLE_NAMESPACE_ADMIN, Namespace: "Not_Valid"},
+			expectedErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			role, err := bindEndpoint.validateRole(tc.parameters)
+
+			// then
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, role)
+		})
+	}
+}
+
+func TestBindEndpoint_BindingsQuota(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	err := db.Instances().Insert(fixture.FixInstance("instance-id"))
+	require.NoError(t, err)
+
+	for id, binding := range map[string]struct {
+		expiresAt time.Time
+		state     domain.LastOperationState
+	}{
+		"active":      {expiresAt: time.Now().Add(time.Hour), state: domain.Succeeded},
+		"in-progress": {expiresAt: time.Now().Add(time.Hour), state: domain.InProgress},
+		"expired":     {expiresAt: time.Now().Add(-time.Hour), state: domain.Succeeded},
+		"failed":      {expiresAt: time.Now().Add(time.Hour), state: domain.Failed},
+	} {
+		fixBinding := fixture.FixBindingWithInstanceID(id, "instance-id")
+		fixBinding.ExpiresAt = binding.expiresAt
+		fixBinding.OperationState = binding.state
+		err = db.Bindings().Insert(&fixBinding)
+		require.NoError(t, err)
+	}
+
+	queue := &automock.Queue{}
+	queue.On("Add", mock.AnythingOfType("string")).Return()
+
+	cfg := BindingConfig{
+		Enabled:                true,
+		BindablePlans:          EnablePlans{fixture.PlanName},
+		ExpirationSeconds:      600,
+		MaxExpirationSeconds:   7200,
+		MinExpirationSeconds:   600,
+		AllowedRoles:           []string{internal.BINDING_ROLE_CLUSTER_ADMIN},
+		AsyncOperationsEnabled: true,
+	}
+
+	t.Run("should reject a binding when the limit is reached", func(t *testing.T) {
+		// given
+		cfg.MaxBindingsCount = 2
+		bindEndpoint := NewBind(cfg, db.Instances(), db.Bindings(), logrus.New(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+		// when
+		_, err := bindEndpoint.Bind(context.Background(), "instance-id", "new-binding", domain.BindDetails{}, true)
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should return an existing binding when the limit is reached", func(t *testing.T) {
+		// given
+		cfg.MaxBindingsCount = 2
+		bindEndpoint := NewBind(cfg, db.Instances(), db.Bindings(), logrus.New(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+		// when
+		binding, err := bindEndpoint.Bind(context.Background(), "instance-id", "active", domain.BindDetails{RawParameters: json.RawMessage(`{"service_account": true}`)}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.AlreadyExists)
+	})
+
+	t.Run("should create a binding below the limit", func(t *testing.T) {
+		// given
+		cfg.MaxBindingsCount = 3
+		bindEndpoint := NewBind(cfg, db.Instances(), db.Bindings(), logrus.New(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+		// when
+		binding, err := bindEndpoint.Bind(context.Background(), "instance-id", "new-binding", domain.BindDetails{}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.IsAsync)
+	})
+
+	t.Run("should reject a binding stored concurrently beyond the limit", func(t *testing.T) {
+		// given
+		cfg.MaxBindingsCount = 3
+		bindEndpoint := NewBind(cfg, db.Instances(), db.Bindings(), logrus.New(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+		concurrent := fixture.FixBindingWithInstanceID("concurrent", "instance-id")
+		concurrent.CreatedAt = time.Now().Add(time.Minute)
+		concurrent.ExpiresAt = time.Now().Add(time.Hour)
+		err := db.Bindings().Insert(&concurrent)
+		require.NoError(t, err)
+		earlier, err := db.Bindings().GetByBindingID("new-binding")
+		require.NoError(t, err)
+
+		// when
+		errConcurrent := bindEndpoint.checkBindingsLimit(&concurrent)
+		errEarlier := bindEndpoint.checkBindingsLimit(earlier)
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, errConcurrent)
+		assert.Equal(t, http.StatusBadRequest, errConcurrent.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		assert.NoError(t, errEarlier)
+	})
+}
+
 func createKubeconfigFileForRestConfig(restConfig rest.Config) []byte {
 	const (
 		userName    = "user"
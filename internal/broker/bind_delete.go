@@ -2,17 +2,38 @@ package broker
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	broker "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type UnbindEndpoint struct {
-	log logrus.FieldLogger
+	config           BindingConfig
+	log              logrus.FieldLogger
+	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
+
+	serviceAccountBindingManager broker.BindingsManager
+	gardenerBindingsManager      broker.BindingsManager
+
+	queue Queue
 }
 
-func NewUnbind(log logrus.FieldLogger) *UnbindEndpoint {
-	return &UnbindEndpoint{log: log.WithField("service", "UnbindEndpoint")}
+func NewUnbind(cfg BindingConfig, log logrus.FieldLogger, instancesStorage storage.Instances, bindingsStorage storage.Bindings, clientProvider broker.ClientProvider, kubeconfigProvider broker.KubeconfigProvider, gardenerClient client.Client, queue Queue) *UnbindEndpoint {
+	return &UnbindEndpoint{config: cfg, log: log.WithField("service", "UnbindEndpoint"), instancesStorage: instancesStorage, bindingsStorage: bindingsStorage,
+		serviceAccountBindingManager: broker.NewServiceAccountBindingsManager(clientProvider, kubeconfigProvider),
+		gardenerBindingsManager:      broker.NewGardenerBindingManager(gardenerClient),
+		queue:                        queue,
+	}
 }
 
 // Unbind deletes an existing service binding
@@ -23,7 +44,74 @@ func (b *UnbindEndpoint) Unbind(ctx context.Context, instanceID, bindingID strin
 	b.log.Infof("Unbind details: %+v", details)
 	b.log.Infof("Unbind asyncAllowed: %v", asyncAllowed)
 
+	binding, err := b.bindingsStorage.GetByBindingID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	case err != nil:
+		message := fmt.Sprintf("failed to get binding %s from storage: %s", bindingID, err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	case binding.InstanceID != instanceID:
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	case binding.OperationType == internal.BindingOperationTypeUnbind && binding.OperationState == domain.InProgress:
+		if !asyncAllowed {
+			return domain.UnbindSpec{}, apiresponses.ErrAsyncRequired
+		}
+		return domain.UnbindSpec{
+			IsAsync:       true,
+			OperationData: string(internal.BindingOperationTypeUnbind),
+		}, nil
+	case binding.OperationType == internal.BindingOperationTypeBind && binding.OperationState == domain.InProgress:
+		return domain.UnbindSpec{}, apiresponses.ErrConcurrentInstanceAccess
+	}
+
+	instance, err := b.instancesStorage.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		b.log.Infof("instance %s does not exist, removing binding %s from storage only", instanceID, bindingID)
+	case err != nil:
+		message := fmt.Sprintf("failed to get instance %s", instanceID)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	case b.config.AsyncOperationsEnabled && asyncAllowed:
+		binding.UpdatedAt = time.Now()
+		binding.OperationType = internal.BindingOperationTypeUnbind
+		binding.OperationState = domain.InProgress
+		binding.OperationDescription = "unbinding in progress"
+		err = b.bindingsStorage.Update(binding)
+		if err != nil {
+			message := fmt.Sprintf("failed to update binding %s in storage: %s", bindingID, err)
+			return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+		}
+
+		b.log.Infof("Adding binding %s to the binding queue", bindingID)
+		b.queue.Add(bindingID)
+
+		return domain.UnbindSpec{
+			IsAsync:       true,
+			OperationData: string(internal.BindingOperationTypeUnbind),
+		}, nil
+	default:
+		err = b.bindingsManager(binding.BindingType).Delete(ctx, instance, binding)
+		if err != nil {
+			message := fmt.Sprintf("failed to delete kyma binding %s: %s", bindingID, err)
+			return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+		}
+	}
+
+	err = b.bindingsStorage.DeleteByBindingID(bindingID)
+	if err != nil {
+		message := fmt.Sprintf("failed to delete binding %s from storage: %s", bindingID, err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	}
+
 	return domain.UnbindSpec{
 		IsAsync: false,
 	}, nil
 }
+
+func (b *UnbindEndpoint) bindingsManager(bindingType string) broker.BindingsManager {
+	if bindingType == internal.BINDING_TYPE_SERVICE_ACCOUNT {
+		return b.serviceAccountBindingManager
+	}
+	return b.gardenerBindingsManager
+}
@@ -0,0 +1,99 @@
+package broker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUnbindEndpoint_UnbindAdminKubeconfigBinding(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixture.FixBindingWithInstanceID("binding-id", instanceID)
+	binding.BindingType = internal.BINDING_TYPE_ADMIN_KUBECONFIG
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	svc := broker.NewUnbind(broker.BindingConfig{}, logrus.New(), st.Instances(), st.Bindings(), nil, nil, fake.NewClientBuilder().Build(), nil)
+
+	// when
+	spec, err := svc.Unbind(context.Background(), instanceID, "binding-id", domain.UnbindDetails{}, false)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, spec.IsAsync)
+	_, err = st.Bindings().GetByBindingID("binding-id")
+	assert.True(t, dberr.IsNotFound(err))
+}
+
+func TestUnbindEndpoint_UnbindNonExistingBinding(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	binding := fixture.FixBindingWithInstanceID("binding-id", "other-instance-id")
+	err := st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	svc := broker.NewUnbind(broker.BindingConfig{}, logrus.New(), st.Instances(), st.Bindings(), nil, nil, fake.NewClientBuilder().Build(), nil)
+
+	for name, bindingID := range map[string]string{
+		"binding does not exist":              "non-existing-binding-id",
+		"binding belongs to another instance": "binding-id",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, err := svc.Unbind(context.Background(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+			// then
+			require.IsType(t, &apiresponses.FailureResponse{}, err)
+			apierr := err.(*apiresponses.FailureResponse)
+			assert.Equal(t, http.StatusGone, apierr.ValidatedStatusCode(nil))
+		})
+	}
+
+	_, err = st.Bindings().GetByBindingID("binding-id")
+	assert.NoError(t, err)
+}
+
+func TestUnbindEndpoint_AsyncUnbind(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixture.FixBindingWithInstanceID("binding-id", instanceID)
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	queue := &automock.Queue{}
+	queue.On("Add", "binding-id").Return().Once()
+	defer queue.AssertExpectations(t)
+
+	svc := broker.NewUnbind(broker.BindingConfig{AsyncOperationsEnabled: true}, logrus.New(), st.Instances(), st.Bindings(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+	// when
+	spec, err := svc.Unbind(context.Background(), instanceID, "binding-id", domain.UnbindDetails{}, true)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, spec.IsAsync)
+	assert.Equal(t, string(internal.BindingOperationTypeUnbind), spec.OperationData)
+
+	stored, err := st.Bindings().GetByBindingID("binding-id")
+	require.NoError(t, err)
+	assert.Equal(t, internal.BindingOperationTypeUnbind, stored.OperationType)
+	assert.Equal(t, domain.InProgress, stored.OperationState)
+}
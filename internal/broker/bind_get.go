@@ -2,17 +2,23 @@ package broker
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type GetBindingEndpoint struct {
-	log logrus.FieldLogger
+	bindings storage.Bindings
+	log      logrus.FieldLogger
 }
 
-func NewGetBinding(log logrus.FieldLogger) *GetBindingEndpoint {
-	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint")}
+func NewGetBinding(log logrus.FieldLogger, bindings storage.Bindings) *GetBindingEndpoint {
+	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint"), bindings: bindings}
 }
 
 // GetBinding fetches an existing service binding
@@ -22,7 +28,23 @@ func (b *GetBindingEndpoint) GetBinding(_ context.Context, instanceID, bindingID
 	b.log.Infof("GetBinding instanceID: %s", instanceID)
 	b.log.Infof("GetBinding bindingID: %s", bindingID)
 
+	binding, err := b.bindings.GetByBindingID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	case err != nil:
+		message := fmt.Sprintf("failed to get binding %s from storage: %s", bindingID, err)
+		return domain.GetBindingSpec{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	case binding.InstanceID != instanceID:
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	case !binding.IsBound():
+		// the binding is being created, could not be created or is being deleted
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	}
+
 	return domain.GetBindingSpec{
-		Credentials: dummyCredentials,
+		Credentials: Credentials{
+			Kubeconfig: binding.Kubeconfig,
+		},
 	}, nil
 }
@@ -0,0 +1,58 @@
+package broker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBindingEndpoint_GetExistingBinding(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	binding := fixture.FixBindingWithInstanceID("binding-id", instanceID)
+	err := st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	svc := broker.NewGetBinding(logrus.New(), st.Bindings())
+
+	// when
+	spec, err := svc.GetBinding(context.Background(), instanceID, "binding-id", domain.FetchBindingDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, broker.Credentials{Kubeconfig: binding.Kubeconfig}, spec.Credentials)
+}
+
+func TestGetBindingEndpoint_GetNonExistingBinding(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	binding := fixture.FixBindingWithInstanceID("binding-id", "other-instance-id")
+	err := st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	svc := broker.NewGetBinding(logrus.New(), st.Bindings())
+
+	for name, bindingID := range map[string]string{
+		"binding does not exist":              "non-existing-binding-id",
+		"binding belongs to another instance": "binding-id",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, err := svc.GetBinding(context.Background(), instanceID, bindingID, domain.FetchBindingDetails{})
+
+			// then
+			require.IsType(t, &apiresponses.FailureResponse{}, err)
+			apierr := err.(*apiresponses.FailureResponse)
+			assert.Equal(t, http.StatusNotFound, apierr.ValidatedStatusCode(nil))
+		})
+	}
+}
@@ -3,17 +3,23 @@ package broker
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type LastBindingOperationEndpoint struct {
-	log logrus.FieldLogger
+	bindings storage.Bindings
+	log      logrus.FieldLogger
 }
 
-func NewLastBindingOperation(log logrus.FieldLogger) *LastBindingOperationEndpoint {
-	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint")}
+func NewLastBindingOperation(log logrus.FieldLogger, bindings storage.Bindings) *LastBindingOperationEndpoint {
+	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint"), bindings: bindings}
 }
 
 // LastBindingOperation fetches last operation state for a service binding
@@ -24,5 +30,26 @@ func (b *LastBindingOperationEndpoint) LastBindingOperation(ctx context.Context,
 	b.log.Infof("LastBindingOperation bindingID: %s", bindingID)
 	b.log.Infof("LastBindingOperation details: %+v", details)
 
-	return domain.LastOperation{}, fmt.Errorf("not supported")
+	binding, err := b.bindings.GetByBindingID(bindingID)
+	switch {
+	case dberr.IsNotFound(err) || (err == nil && binding.InstanceID != instanceID):
+		// the binding is removed from the storage when the unbind operation is finished
+		if details.OperationData == string(internal.BindingOperationTypeUnbind) {
+			return domain.LastOperation{}, apiresponses.ErrBindingDoesNotExist
+		}
+		return domain.LastOperation{}, apiresponses.ErrBindingNotFound
+	case err != nil:
+		message := fmt.Sprintf("failed to get binding %s from storage: %s", bindingID, err)
+		return domain.LastOperation{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusInternalServerError, message)
+	}
+
+	if details.OperationData != "" && details.OperationData != string(binding.OperationType) {
+		message := fmt.Sprintf("operation %s does not exist for binding %s, the last operation is %s", details.OperationData, bindingID, binding.OperationType)
+		return domain.LastOperation{}, apiresponses.NewFailureResponse(fmt.Errorf(message), http.StatusBadRequest, message)
+	}
+
+	return domain.LastOperation{
+		State:       binding.OperationState,
+		Description: binding.OperationDescription,
+	}, nil
 }
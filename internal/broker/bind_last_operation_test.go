@@ -0,0 +1,123 @@
+package broker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBindEndpoint_AsyncBind(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+
+	queue := &automock.Queue{}
+	queue.On("Add", "binding-id").Return().Once()
+	defer queue.AssertExpectations(t)
+
+	cfg := broker.BindingConfig{
+		Enabled:                true,
+		BindablePlans:          broker.EnablePlans{fixture.PlanName},
+		ExpirationSeconds:      600,
+		MaxExpirationSeconds:   7200,
+		MinExpirationSeconds:   600,
+		AllowedRoles:           []string{internal.BINDING_ROLE_CLUSTER_ADMIN},
+		AsyncOperationsEnabled: true,
+	}
+	svc := broker.NewBind(cfg, st.Instances(), st.Bindings(), logrus.New(), nil, nil, fake.NewClientBuilder().Build(), queue)
+
+	// when
+	binding, err := svc.Bind(context.Background(), instanceID, "binding-id", domain.BindDetails{}, true)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, binding.IsAsync)
+	assert.Equal(t, string(internal.BindingOperationTypeBind), binding.OperationData)
+
+	stored, err := st.Bindings().GetByBindingID("binding-id")
+	require.NoError(t, err)
+	assert.Equal(t, internal.BindingOperationTypeBind, stored.OperationType)
+	assert.Equal(t, domain.InProgress, stored.OperationState)
+
+	t.Run("should return the operation in progress when the request is repeated", func(t *testing.T) {
+		// when
+		binding, err := svc.Bind(context.Background(), instanceID, "binding-id", domain.BindDetails{}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.IsAsync)
+	})
+
+	t.Run("should require async when the operation is in progress", func(t *testing.T) {
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, "binding-id", domain.BindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrAsyncRequired, err)
+	})
+}
+
+func TestLastBindingOperationEndpoint_LastBindingOperation(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	binding := fixture.FixBindingWithInstanceID("binding-id", instanceID)
+	binding.OperationState = domain.InProgress
+	binding.OperationDescription = "binding in progress"
+	err := st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	svc := broker.NewLastBindingOperation(logrus.New(), st.Bindings())
+
+	t.Run("should return the state of the binding operation", func(t *testing.T) {
+		// when
+		op, err := svc.LastBindingOperation(context.Background(), instanceID, "binding-id", domain.PollDetails{OperationData: "bind"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, op.State)
+		assert.Equal(t, "binding in progress", op.Description)
+	})
+
+	t.Run("should return bad request for a different operation", func(t *testing.T) {
+		// when
+		_, err := svc.LastBindingOperation(context.Background(), instanceID, "binding-id", domain.PollDetails{OperationData: "unbind"})
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should return gone for a finished unbind operation", func(t *testing.T) {
+		// when
+		_, err := svc.LastBindingOperation(context.Background(), instanceID, "removed-binding-id", domain.PollDetails{OperationData: "unbind"})
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusGone, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should return not found for a non existing binding", func(t *testing.T) {
+		// when
+		_, err := svc.LastBindingOperation(context.Background(), instanceID, "removed-binding-id", domain.PollDetails{OperationData: "bind"})
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusNotFound, apierr.ValidatedStatusCode(nil))
+	})
+}
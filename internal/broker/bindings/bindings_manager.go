@@ -10,6 +10,7 @@ import (
 	authv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	mv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -18,7 +19,8 @@ type Credentials struct {
 }
 
 type BindingsManager interface {
-	Create(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int) (string, error)
+	Create(ctx context.Context, instance *internal.Instance, binding *internal.Binding) (string, error)
+	Delete(ctx context.Context, instance *internal.Instance, binding *internal.Binding) error
 }
 
 type ClientProvider interface {
@@ -41,14 +43,14 @@ func NewServiceAccountBindingsManager(clientProvider ClientProvider, kubeconfigP
 	}
 }
 
-func (c *ServiceAccountBindingsManager) Create(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int) (string, error) {
+func (c *ServiceAccountBindingsManager) Create(ctx context.Context, instance *internal.Instance, binding *internal.Binding) (string, error) {
 	clientset, err := c.clientProvider.K8sClientSetForRuntimeID(instance.RuntimeID)
 
 	if err != nil {
 		return "", fmt.Errorf("while creating a runtime client for binding creation: %v", err)
 	}
 
-	serviceBindingName := fmt.Sprintf("kyma-binding-%s", bindingID)
+	serviceBindingName := fmt.Sprintf("kyma-binding-%s", binding.ID)
 
 	_, err = clientset.CoreV1().ServiceAccounts("kyma-system").Create(ctx,
 		&v1.ServiceAccount{
@@ -59,76 +61,238 @@ func (c *ServiceAccountBindingsManager) Create(ctx context.Context, instance *in
 			},
 		}, mv1.CreateOptions{})
 
-	if err != nil {
+	// the binding operation can be retried, resources created by the previous attempt are reused
+	if err != nil && !apierrors.IsAlreadyExists(err) {
 		return "", fmt.Errorf("while creating a service account: %v", err)
 	}
 
-	_, err = clientset.RbacV1().ClusterRoles().Create(ctx,
+	rules, err := policyRules(binding.Role)
+	if err != nil {
+		return "", err
+	}
+
+	if binding.Namespace == "" {
+		err = c.createClusterRole(ctx, clientset, serviceBindingName, rules)
+	} else {
+		err = c.createNamespacedRole(ctx, clientset, serviceBindingName, binding.Namespace, rules)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	tokenRequest := &authv1.TokenRequest{
+		ObjectMeta: mv1.ObjectMeta{
+			Name:      serviceBindingName,
+			Namespace: "kyma-system",
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "kcp-kyma-environment-broker"},
+		},
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.Integer64(binding.ExpirationSeconds),
+		},
+	}
+
+	tkn, err := clientset.CoreV1().ServiceAccounts("kyma-system").CreateToken(ctx, serviceBindingName, tokenRequest, mv1.CreateOptions{})
+
+	if err != nil {
+		return "", fmt.Errorf("while creating a token request: %v", err)
+	}
+
+	kubeconfigContent, err := c.kubeconfigBuilder.BuildFromAdminKubeconfigForBinding(instance.RuntimeID, tkn.Status.Token)
+
+	if err != nil {
+		return "", fmt.Errorf("while creating a kubeconfig: %v", err)
+	}
+
+	return string(kubeconfigContent), nil
+}
+
+func (c *ServiceAccountBindingsManager) createClusterRole(ctx context.Context, clientset kubernetes.Interface, name string, rules []rbacv1.PolicyRule) error {
+	_, err := clientset.RbacV1().ClusterRoles().Create(ctx,
 		&rbacv1.ClusterRole{
 			TypeMeta: mv1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
 			ObjectMeta: mv1.ObjectMeta{
-				Name:   serviceBindingName,
+				Name:   name,
 				Labels: map[string]string{"app.kubernetes.io/managed-by": "kcp-kyma-environment-broker"},
 			},
-			Rules: []rbacv1.PolicyRule{
-				{
-					Verbs:     []string{"*"},
-					APIGroups: []string{"*"},
-					Resources: []string{"*"},
-				},
-			},
+			Rules: rules,
 		}, mv1.CreateOptions{})
 
-	if err != nil {
-		return "", fmt.Errorf("while creating a cluster role: %v", err)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("while creating a cluster role: %v", err)
 	}
 
 	_, err = clientset.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
 		TypeMeta: mv1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"},
 		ObjectMeta: mv1.ObjectMeta{
-			Name:   serviceBindingName,
+			Name:   name,
 			Labels: map[string]string{"app.kubernetes.io/managed-by": "kcp-kyma-environment-broker"},
 		},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "ClusterRole",
-			Name:     serviceBindingName,
+			Name:     name,
 		},
 		Subjects: []rbacv1.Subject{
 			{
 				Kind:      rbacv1.ServiceAccountKind,
 				Namespace: "kyma-system",
-				Name:      serviceBindingName,
+				Name:      name,
 			},
 		},
 	}, mv1.CreateOptions{})
 
-	if err != nil {
-		return "", fmt.Errorf("while creating a cluster role binding: %v", err)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("while creating a cluster role binding: %v", err)
 	}
 
-	tokenRequest := &authv1.TokenRequest{
+	return nil
+}
+
+func (c *ServiceAccountBindingsManager) createNamespacedRole(ctx context.Context, clientset kubernetes.Interface, name, namespace string, rules []rbacv1.PolicyRule) error {
+	_, err := clientset.RbacV1().Roles(namespace).Create(ctx,
+		&rbacv1.Role{
+			TypeMeta: mv1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+			ObjectMeta: mv1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "kcp-kyma-environment-broker"},
+			},
+			Rules: rules,
+		}, mv1.CreateOptions{})
+
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("while creating a role in namespace %s: %v", namespace, err)
+	}
+
+	_, err = clientset.RbacV1().RoleBindings(namespace).Create(ctx, &rbacv1.RoleBinding{
+		TypeMeta: mv1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "RoleBinding"},
 		ObjectMeta: mv1.ObjectMeta{
-			Name:      serviceBindingName,
-			Namespace: "kyma-system",
+			Name:      name,
+			Namespace: namespace,
 			Labels:    map[string]string{"app.kubernetes.io/managed-by": "kcp-kyma-environment-broker"},
 		},
-		Spec: authv1.TokenRequestSpec{
-			ExpirationSeconds: ptr.Integer64(int64(expirationSeconds)),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Namespace: "kyma-system",
+				Name:      name,
+			},
 		},
+	}, mv1.CreateOptions{})
+
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("while creating a role binding in namespace %s: %v", namespace, err)
 	}
 
-	tkn, err := clientset.CoreV1().ServiceAccounts("kyma-system").CreateToken(ctx, serviceBindingName, tokenRequest, mv1.CreateOptions{})
+	return nil
+}
+
+func (c *ServiceAccountBindingsManager) Delete(ctx context.Context, instance *internal.Instance, binding *internal.Binding) error {
+	clientset, err := c.clientProvider.K8sClientSetForRuntimeID(instance.RuntimeID)
 
 	if err != nil {
-		return "", fmt.Errorf("while creating a token request: %v", err)
+		return fmt.Errorf("while creating a runtime client for binding deletion: %v", err)
 	}
 
-	kubeconfigContent, err := c.kubeconfigBuilder.BuildFromAdminKubeconfigForBinding(instance.RuntimeID, tkn.Status.Token)
+	serviceBindingName := fmt.Sprintf("kyma-binding-%s", binding.ID)
 
-	if err != nil {
-		return "", fmt.Errorf("while creating a kubeconfig: %v", err)
+	if binding.Namespace == "" {
+		err = clientset.RbacV1().ClusterRoleBindings().Delete(ctx, serviceBindingName, mv1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("while deleting a cluster role binding: %v", err)
+		}
+
+		err = clientset.RbacV1().ClusterRoles().Delete(ctx, serviceBindingName, mv1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("while deleting a cluster role: %v", err)
+		}
+	} else {
+		err = clientset.RbacV1().RoleBindings(binding.Namespace).Delete(ctx, serviceBindingName, mv1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("while deleting a role binding in namespace %s: %v", binding.Namespace, err)
+		}
+
+		err = clientset.RbacV1().Roles(binding.Namespace).Delete(ctx, serviceBindingName, mv1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("while deleting a role in namespace %s: %v", binding.Namespace, err)
+		}
 	}
 
-	return string(kubeconfigContent), nil
+	// deleting the service account invalidates all tokens issued for it
+	err = clientset.CoreV1().ServiceAccounts("kyma-system").Delete(ctx, serviceBindingName, mv1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("while deleting a service account: %v", err)
+	}
+
+	return nil
+}
+
+// policyRules returns the rules granted by the given role profile, an empty role means cluster-admin
+// to keep bindings created before the role profiles were introduced working
+func policyRules(role string) ([]rbacv1.PolicyRule, error) {
+	switch role {
+	case "", internal.BINDING_ROLE_CLUSTER_ADMIN, internal.BINDING_ROLE_NAMESPACE_ADMIN:
+		return []rbacv1.PolicyRule{
+			{
+				Verbs:     []string{"*"},
+				APIGroups: []string{"*"},
+				Resources: []string{"*"},
+			},
+		}, nil
+	case internal.BINDING_ROLE_READ_ONLY:
+		return readOnlyPolicyRules(), nil
+	default:
+		return nil, fmt.Errorf("unknown binding role %q", role)
+	}
+}
+
+// readOnlyPolicyRules lists the namespaced workload resources which can be read with the read-only role profile.
+// Secrets are purposefully not listed, they contain credentials, including the service account tokens.
+// Cluster-scoped resources are not listed, the rules must have the same effect in a Role and in a ClusterRole.
+func readOnlyPolicyRules() []rbacv1.PolicyRule {
+	verbs := []string{"get", "list", "watch"}
+	return []rbacv1.PolicyRule{
+		{
+			Verbs:     verbs,
+			APIGroups: []string{""},
+			Resources: []string{"pods", "pods/log", "pods/status", "services", "endpoints", "configmaps", "persistentvolumeclaims", "persistentvolumeclaims/status",
+				"replicationcontrollers", "replicationcontrollers/status", "serviceaccounts", "events", "limitranges", "resourcequotas", "resourcequotas/status"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "deployments/status", "daemonsets", "daemonsets/status", "replicasets", "replicasets/status",
+				"statefulsets", "statefulsets/status", "controllerrevisions"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"batch"},
+			Resources: []string{"jobs", "jobs/status", "cronjobs", "cronjobs/status"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"autoscaling"},
+			Resources: []string{"horizontalpodautoscalers", "horizontalpodautoscalers/status"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"policy"},
+			Resources: []string{"poddisruptionbudgets", "poddisruptionbudgets/status"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"networking.k8s.io"},
+			Resources: []string{"ingresses", "ingresses/status", "networkpolicies"},
+		},
+		{
+			Verbs:     verbs,
+			APIGroups: []string{"discovery.k8s.io"},
+			Resources: []string{"endpointslices"},
+		},
+	}
 }
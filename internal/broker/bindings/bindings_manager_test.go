@@ -0,0 +1,49 @@
+package broker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestPolicyRules_ReadOnly(t *testing.T) {
+	// when
+	rules, err := policyRules(internal.BINDING_ROLE_READ_ONLY)
+
+	// then
+	require.NoError(t, err)
+	for _, verb := range []string{"get", "list", "watch"} {
+		assert.False(t, rulesAllow(rules, verb, "", "secrets"), "secrets must not be readable with the %s verb", verb)
+		assert.True(t, rulesAllow(rules, verb, "", "pods"))
+		assert.True(t, rulesAllow(rules, verb, "apps", "deployments"))
+		assert.False(t, rulesAllow(rules, verb, "", "namespaces"), "cluster-scoped namespaces must not be listed")
+	}
+	for _, verb := range []string{"create", "update", "patch", "delete"} {
+		assert.False(t, rulesAllow(rules, verb, "", "pods"))
+	}
+}
+
+func TestPolicyRules_ClusterAdmin(t *testing.T) {
+	// when
+	rules, err := policyRules(internal.BINDING_ROLE_CLUSTER_ADMIN)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, rulesAllow(rules, "get", "", "secrets"))
+}
+
+func rulesAllow(rules []rbacv1.PolicyRule, verb, apiGroup, resource string) bool {
+	matches := func(values []string, value string) bool {
+		return slices.Contains(values, rbacv1.VerbAll) || slices.Contains(values, value)
+	}
+	for _, rule := range rules {
+		if matches(rule.Verbs, verb) && matches(rule.APIGroups, apiGroup) && matches(rule.Resources, resource) {
+			return true
+		}
+	}
+	return false
+}
@@ -22,7 +22,7 @@ func NewGardenerBindingManager(gardenerClient client.Client) *GardenerBindingMan
 	}
 }
 
-func (c *GardenerBindingManager) Create(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int) (string, error) {
+func (c *GardenerBindingManager) Create(ctx context.Context, instance *internal.Instance, binding *internal.Binding) (string, error) {
 
 	shoot := &shoot.Shoot{
 		TypeMeta: metav1.TypeMeta{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
@@ -34,7 +34,7 @@ func (c *GardenerBindingManager) Create(ctx context.Context, instance *internal.
 
 	adminKubeconfigRequest := &authenticationv1alpha1.AdminKubeconfigRequest{
 		Spec: authenticationv1alpha1.AdminKubeconfigRequestSpec{
-			ExpirationSeconds: ptr.Integer64(int64(expirationSeconds)),
+			ExpirationSeconds: ptr.Integer64(binding.ExpirationSeconds),
 		},
 	}
 
@@ -46,3 +46,8 @@ func (c *GardenerBindingManager) Create(ctx context.Context, instance *internal.
 
 	return string(shootKubeconfig), nil
 }
+
+// Delete does nothing, the kubeconfig generated with the adminkubeconfig subresource cannot be revoked and is valid until it expires
+func (c *GardenerBindingManager) Delete(ctx context.Context, instance *internal.Instance, binding *internal.Binding) error {
+	return nil
+}
@@ -50,6 +50,8 @@ type Config struct {
 	TrialDocsURL                            string        `envconfig:"default="`
 	EnableShootAndSeedSameRegion            bool          `envconfig:"default=false"`
 	AllowUpdateExpiredInstanceWithContext   bool          `envconfig:"default=false"`
+	PlanUpgrades                            PlanUpgrades  `envconfig:"optional"`
+	RuntimeVersions                         RuntimeVersionsConfig
 
 	Binding                BindingConfig
 	KimConfig              KimConfig
@@ -116,15 +118,20 @@ type EnablePlans []string
 
 // Unmarshal provides custom parsing of enabled plans.
 // Implements envconfig.Unmarshal interface.
+// The names are checked by Validate, after plans from the plans definitions file are registered.
 func (m *EnablePlans) Unmarshal(in string) error {
 	plans := strings.Split(in, ",")
-	for _, name := range plans {
-		if _, exists := PlanIDsMapping[name]; !exists {
+	*m = plans
+	return nil
+}
+
+// Validate checks if all enabled plans are known
+func (m EnablePlans) Validate() error {
+	for _, name := range m {
+		if _, exists := PlanIDForName(name); !exists {
 			return fmt.Errorf("unrecognized %v plan name", name)
 		}
 	}
-
-	*m = plans
 	return nil
 }
 
@@ -2,6 +2,7 @@ package broker
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/utils"
 )
@@ -16,11 +17,13 @@ type ConvergedCloudRegionProvider interface {
 }
 
 type DefaultConvergedCloudRegionsProvider struct {
-	// placeholder
+	reader RegionReader
+
+	mu                  sync.RWMutex
 	regionConfiguration map[string][]string
 }
 
-func NewDefaultConvergedCloudRegionsProvider(regionConfigurationPath string, reader RegionReader) (ConvergedCloudRegionProvider, error) {
+func NewDefaultConvergedCloudRegionsProvider(regionConfigurationPath string, reader RegionReader) (*DefaultConvergedCloudRegionsProvider, error) {
 	if regionConfigurationPath == "" {
 		return nil, fmt.Errorf("regionConfigurationPath cannot be empty")
 	}
@@ -35,11 +38,33 @@ func NewDefaultConvergedCloudRegionsProvider(regionConfigurationPath string, rea
 	}
 
 	return &DefaultConvergedCloudRegionsProvider{
+		reader:              reader,
 		regionConfiguration: regionConfiguration,
 	}, nil
 }
 
+// Reload reads the region mappings again, the current mappings are kept if the file cannot be read
+func (c *DefaultConvergedCloudRegionsProvider) Reload(regionConfigurationPath string) error {
+	regionConfiguration, err := c.reader.Read(regionConfigurationPath)
+	if err != nil {
+		return fmt.Errorf("while unmarshalling a file with sap-converged-cloud region mappings: %w", err)
+	}
+	for platformRegion, regions := range regionConfiguration {
+		if len(regions) == 0 {
+			return fmt.Errorf("no %s regions defined for the platform region %s", SapConvergedCloudPlanName, platformRegion)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.regionConfiguration = regionConfiguration
+	return nil
+}
+
 func (c *DefaultConvergedCloudRegionsProvider) GetRegions(mappedRegion string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	item, found := c.regionConfiguration[mappedRegion]
 
 	if !found {
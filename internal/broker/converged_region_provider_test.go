@@ -1,6 +1,7 @@
 package broker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/broker/automock"
@@ -35,7 +36,7 @@ func TestPathBasedConvergedCloudRegionsProvider_FactoryMethod(t *testing.T) {
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, regions, provider.(*DefaultConvergedCloudRegionsProvider).regionConfiguration)
+		assert.Equal(t, regions, provider.regionConfiguration)
 	})
 
 	t.Run("should return error if called with incorrect configuration", func(t *testing.T) {
@@ -127,3 +128,45 @@ func TestPathBasedConvergedCloudRegionsProvider_GetRegions(t *testing.T) {
 	})
 
 }
+
+func TestPathBasedConvergedCloudRegionsProvider_Reload(t *testing.T) {
+	// given
+	mockReader := automock.NewRegionReader(t)
+	mockReader.On("Read", "path-to-config").Return(map[string][]string{"key": {"value"}}, nil).Once()
+	provider, err := NewDefaultConvergedCloudRegionsProvider("path-to-config", mockReader)
+	assert.NoError(t, err)
+
+	t.Run("should replace mappings", func(t *testing.T) {
+		// given
+		mockReader.On("Read", "path-to-config").Return(map[string][]string{"key": {"value1", "value2"}}, nil).Once()
+
+		// when
+		err := provider.Reload("path-to-config")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"value1", "value2"}, provider.GetRegions("key"))
+	})
+
+	t.Run("should keep mappings when file is invalid", func(t *testing.T) {
+		for name, result := range map[string]struct {
+			regions map[string][]string
+			err     error
+		}{
+			"unreadable file": {err: fmt.Errorf("invalid yaml")},
+			"empty regions":   {regions: map[string][]string{"key": {}}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				mockReader.On("Read", "path-to-config").Return(result.regions, result.err).Once()
+
+				// when
+				err := provider.Reload("path-to-config")
+
+				// then
+				assert.Error(t, err)
+				assert.Equal(t, []string{"value1", "value2"}, provider.GetRegions("key"))
+			})
+		}
+	})
+}
@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"net/netip"
 	"strings"
+	"sync"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/assuredworkloads"
 
@@ -75,6 +75,9 @@ type ProvisionEndpoint struct {
 
 	convergedCloudRegionsProvider ConvergedCloudRegionProvider
 
+	// mu guards the configuration which can be reloaded from files: plansConfig, shootDnsProviders and freemiumWhiteList
+	mu sync.RWMutex
+
 	log logrus.FieldLogger
 }
 
@@ -99,7 +102,7 @@ func NewProvision(cfg Config,
 ) *ProvisionEndpoint {
 	enabledPlanIDs := map[string]struct{}{}
 	for _, planName := range cfg.EnablePlans {
-		id := PlanIDsMapping[planName]
+		id, _ := PlanIDForName(planName)
 		enabledPlanIDs[id] = struct{}{}
 	}
 
@@ -124,6 +127,45 @@ func NewProvision(cfg Config,
 	}
 }
 
+// SetPlansConfig replaces the plans configuration read from the catalog file
+func (b *ProvisionEndpoint) SetPlansConfig(plansConfig PlansConfig) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.plansConfig = plansConfig
+}
+
+// SetDNSProviders replaces the DNS providers set for new shoots
+func (b *ProvisionEndpoint) SetDNSProviders(dnsProviders gardener.DNSProvidersData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.shootDnsProviders = dnsProviders
+}
+
+// SetFreemiumWhitelist replaces the global accounts allowed to create more than one freemium instance
+func (b *ProvisionEndpoint) SetFreemiumWhitelist(freemiumWhitelist whitelist.Set) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.freemiumWhiteList = freemiumWhitelist
+}
+
+func (b *ProvisionEndpoint) currentPlansConfig() PlansConfig {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.plansConfig
+}
+
+func (b *ProvisionEndpoint) currentDNSProviders() gardener.DNSProvidersData {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.shootDnsProviders
+}
+
+func (b *ProvisionEndpoint) currentFreemiumWhitelist() whitelist.Set {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.freemiumWhiteList
+}
+
 // Provision creates a new service instance
 //
 //	PUT /v2/service_instances/{instance_id}
@@ -150,7 +192,7 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, errMsg)
 	}
 
-	if b.config.DisableSapConvergedCloud && details.PlanID == SapConvergedCloudPlanID {
+	if b.config.DisableSapConvergedCloud && IsSapConvergedCloudPlan(details.PlanID) {
 		err := fmt.Errorf(CONVERGED_CLOUD_BLOCKED_MSG)
 		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, CONVERGED_CLOUD_BLOCKED_MSG)
 	}
@@ -193,7 +235,7 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 
 	operation.ShootName = shootName
 	operation.ShootDomain = fmt.Sprintf("%s.%s", shootName, shootDomainSuffix)
-	operation.ShootDNSProviders = b.shootDnsProviders
+	operation.ShootDNSProviders = b.currentDNSProviders()
 	operation.DashboardURL = dashboardURL
 	// for own cluster plan - KEB uses provided shoot name and shoot domain
 	if IsOwnClusterPlan(provisioningParameters.PlanID) {
@@ -215,7 +257,7 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		ServiceID:       provisioningParameters.ServiceID,
 		ServiceName:     KymaServiceName,
 		ServicePlanID:   provisioningParameters.PlanID,
-		ServicePlanName: PlanNamesMapping[provisioningParameters.PlanID],
+		ServicePlanName: PlanNameForID(provisioningParameters.PlanID),
 		DashboardURL:    dashboardURL,
 		Parameters:      operation.ProvisioningParameters,
 	}
@@ -300,6 +342,17 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if err := internal.ValidateAdditionalWorkerNodePools(parameters.AdditionalWorkerNodePools); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if parameters.MaintenanceWindow != nil {
+		if err := parameters.MaintenanceWindow.Validate(); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+	if err := b.config.RuntimeVersions.ValidateParameters(details.PlanID, ersContext.GlobalAccountID, parameters); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
 
 	planValidator, err := b.validator(&details, provider, ctx)
 	if err != nil {
@@ -356,7 +409,7 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 		}
 	}
 
-	if IsFreemiumPlan(details.PlanID) && b.config.OnlyOneFreePerGA && whitelist.IsNotWhitelisted(ersContext.GlobalAccountID, b.freemiumWhiteList) {
+	if IsFreemiumPlan(details.PlanID) && b.config.OnlyOneFreePerGA && whitelist.IsNotWhitelisted(ersContext.GlobalAccountID, b.currentFreemiumWhitelist()) {
 		count, err := b.instanceArchivedStorage.TotalNumberOfInstancesArchivedForGlobalAccountID(ersContext.GlobalAccountID, FreemiumPlanID)
 		if err != nil {
 			return ersContext, parameters, fmt.Errorf("while checking if a free Kyma instance existed for given global account: %w", err)
@@ -459,7 +512,7 @@ func (b *ProvisionEndpoint) handleExistingOperation(operation *internal.Provisio
 }
 
 func (b *ProvisionEndpoint) determineLicenceType(planId string) *string {
-	if planId == AzureLitePlanID || IsTrialPlan(planId) {
+	if BasePlanID(planId) == AzureLitePlanID || IsTrialPlan(planId) {
 		return ptr.String(internal.LicenceTypeLite)
 	}
 
@@ -468,7 +521,7 @@ func (b *ProvisionEndpoint) determineLicenceType(planId string) *string {
 
 func (b *ProvisionEndpoint) validator(details *domain.ProvisionDetails, provider internal.CloudProvider, ctx context.Context) (JSONSchemaValidator, error) {
 	platformRegion, _ := middleware.RegionFromContext(ctx)
-	plans := Plans(b.plansConfig, provider, b.config.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion), b.config.UseSmallerMachineTypes, b.config.EnableShootAndSeedSameRegion, b.convergedCloudRegionsProvider.GetRegions(platformRegion), assuredworkloads.IsKSA(platformRegion))
+	plans := Plans(b.currentPlansConfig(), provider, b.config.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion), b.config.UseSmallerMachineTypes, b.config.EnableShootAndSeedSameRegion, b.convergedCloudRegionsProvider.GetRegions(platformRegion), assuredworkloads.IsKSA(platformRegion))
 	plan := plans[details.PlanID]
 	schema := string(Marshal(plan.Schemas.Instance.Create.Parameters))
 
@@ -511,12 +564,6 @@ func (b *ProvisionEndpoint) validateNetworking(parameters internal.ProvisioningP
 	if nodes, e = validateCidr(parameters.Networking.NodesCidr); e != nil {
 		err = multierror.Append(err, fmt.Errorf("while parsing nodes CIDR: %w", e))
 	}
-	// error is handled before, in the validate CIDR
-	cidr, _ := netip.ParsePrefix(parameters.Networking.NodesCidr)
-	if cidr.Bits() > 23 {
-		err = multierror.Append(err, fmt.Errorf("the suffix of the node CIDR must not be greater than 26"))
-	}
-
 	if parameters.Networking.PodsCidr != nil {
 		if pods, e = validateCidr(*parameters.Networking.PodsCidr); e != nil {
 			err = multierror.Append(err, fmt.Errorf("while parsing pods CIDR: %w", e))
@@ -535,16 +582,36 @@ func (b *ProvisionEndpoint) validateNetworking(parameters internal.ProvisioningP
 		return err
 	}
 
+	for _, c := range []struct {
+		name         string
+		cidr         *net.IPNet
+		maxPrefixLen int
+	}{
+		{name: "nodes", cidr: nodes, maxPrefixLen: networking.MaxNodesPrefixLength},
+		{name: "pods", cidr: pods, maxPrefixLen: networking.MaxPodsPrefixLength},
+		{name: "services", cidr: services, maxPrefixLen: networking.MaxServicesPrefixLength},
+	} {
+		if ones, _ := c.cidr.Mask.Size(); ones > c.maxPrefixLen {
+			err = multierror.Append(err, fmt.Errorf("the suffix of the %s CIDR %s must not be greater than %d", c.name, c.cidr, c.maxPrefixLen))
+		}
+		if !isPrivateCidr(*c.cidr) {
+			err = multierror.Append(err, fmt.Errorf("the %s CIDR %s must be within one of the private ranges: %s", c.name, c.cidr, strings.Join(networking.PrivateCIDRs, ", ")))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
 	for _, seed := range networking.GardenerSeedCIDRs {
 		_, seedCidr, _ := net.ParseCIDR(seed)
 		if e := validateOverlapping(*nodes, *seedCidr); e != nil {
-			err = multierror.Append(err, fmt.Errorf("nodes CIDR must not overlap %s", seed))
+			err = multierror.Append(err, fmt.Errorf("nodes CIDR %s must not overlap seed CIDR %s", nodes, seed))
 		}
 		if e := validateOverlapping(*services, *seedCidr); e != nil {
-			err = multierror.Append(err, fmt.Errorf("services CIDR must not overlap %s", seed))
+			err = multierror.Append(err, fmt.Errorf("services CIDR %s must not overlap seed CIDR %s", services, seed))
 		}
 		if e := validateOverlapping(*pods, *seedCidr); e != nil {
-			err = multierror.Append(err, fmt.Errorf("pods CIDR must not overlap %s", seed))
+			err = multierror.Append(err, fmt.Errorf("pods CIDR %s must not overlap seed CIDR %s", pods, seed))
 		}
 	}
 
@@ -553,18 +620,31 @@ func (b *ProvisionEndpoint) validateNetworking(parameters internal.ProvisioningP
 	}
 
 	if e := validateOverlapping(*nodes, *pods); e != nil {
-		err = multierror.Append(err, fmt.Errorf("nodes CIDR must not overlap pods CIDR"))
+		err = multierror.Append(err, fmt.Errorf("nodes CIDR %s must not overlap pods CIDR %s", nodes, pods))
 	}
 	if e := validateOverlapping(*nodes, *services); e != nil {
-		err = multierror.Append(err, fmt.Errorf("nodes CIDR must not overlap serivces CIDR"))
+		err = multierror.Append(err, fmt.Errorf("nodes CIDR %s must not overlap services CIDR %s", nodes, services))
 	}
 	if e := validateOverlapping(*services, *pods); e != nil {
-		err = multierror.Append(err, fmt.Errorf("services CIDR must not overlap pods CIDR"))
+		err = multierror.Append(err, fmt.Errorf("services CIDR %s must not overlap pods CIDR %s", services, pods))
 	}
 
 	return err
 }
 
+// isPrivateCidr returns true if the whole CIDR is within one of the RFC 1918 private ranges
+func isPrivateCidr(cidr net.IPNet) bool {
+	ones, _ := cidr.Mask.Size()
+	for _, private := range networking.PrivateCIDRs {
+		_, privateCidr, _ := net.ParseCIDR(private)
+		privateOnes, _ := privateCidr.Mask.Size()
+		if privateCidr.Contains(cidr.IP) && ones >= privateOnes {
+			return true
+		}
+	}
+	return false
+}
+
 func validateOverlapping(n1 net.IPNet, n2 net.IPNet) error {
 
 	if n1.Contains(n2.IP) || n2.Contains(n1.IP) {
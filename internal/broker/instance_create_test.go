@@ -98,7 +98,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s"}`, clusterName, clusterRegion)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -174,7 +174,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "kubeconfig": "%s", "shootName":"%s", "shootDomain":"%s"}`, clusterName, encodedKubeconfig, shootName, shootDomain)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -254,7 +254,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "kubeconfig": "%s", "shootName":"%s", "shootDomain":"%s"}`, clusterName, notEncodedKubeconfig, shootName, shootDomain)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		assert.ErrorContains(t, err, "while decoding kubeconfig")
@@ -305,7 +305,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "kubeconfig": "%s", "shootName":"%s"}`, clusterName, encodedKubeconfig, shootName)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		assert.ErrorContains(t, err, "while validating input parameters: (root): shootDomain is required")
@@ -317,7 +317,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "kubeconfig": "%s", "shootDomain":"%s"}`, clusterName, encodedKubeconfig, shootDomain)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		assert.ErrorContains(t, err, "while validating input parameters: (root): shootName is required")
@@ -329,7 +329,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "shootDomain": "%s", "shootName":"%s"}`, clusterName, shootDomain, shootName)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		assert.ErrorContains(t, err, "while validating input parameters: (root): kubeconfig is required")
@@ -381,7 +381,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s", "kubeconfig": "%s", "shootName":"%s", "shootDomain":"%s"}`, clusterName, clusterRegion, notEncodedKubeconfig, shootName, shootDomain)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -928,7 +928,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, clusterRegion, oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.Error(t, err)
@@ -987,7 +987,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, clusterRegion, oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.Error(t, err)
@@ -1046,7 +1046,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, clusterRegion, oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.Error(t, err)
@@ -1103,7 +1103,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "switzerlandnorth", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "any-global-account-id", subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -1428,7 +1428,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "me-central2", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "any-global-account-id", subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -1481,7 +1481,7 @@ func TestProvision_Provision(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "us-central1", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "any-global-account-id", subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.EqualError(t, err, "while validating input parameters: region: region must be one of the following: \"me-central2\"")
@@ -1492,7 +1492,8 @@ func TestNetworkingValidation(t *testing.T) {
 	for tn, tc := range map[string]struct {
 		givenNetworking string
 
-		expectedError bool
+		expectedError   bool
+		expectedMessage string
 	}{
 		"Invalid nodes CIDR": {
 			givenNetworking: `{"nodes": 1abcd"}`,
@@ -1510,26 +1511,59 @@ func TestNetworkingValidation(t *testing.T) {
 			givenNetworking: `{"nodes": "10.243.128.0/18"}`,
 			expectedError:   true,
 		},
-		/*"Invalid pods CIDR": {
-		  	givenNetworking: `{"nodes": "10.250.0.0/16", "pods": "10abcd/16", "services": "100.104.0.0/13"}`,
-		  	expectedError:   true,
-		  },
-		  "Invalid pods CIDR - wrong IP range": {
-		  	givenNetworking: `{"nodes": "10.250.0.0/16", "pods": "10.250.0.1/19", "services": "100.104.0.0/13"}`,
-		  	expectedError:   true,
-		  },
-		  "Invalid services CIDR": {
-		  	givenNetworking: `{"nodes": "10.250.0.0/16", "pods": "10.250.0.1/19", "services": "abcd"}`,
-		  	expectedError:   true,
-		  },
-		  "Invalid services CIDR - wrong IP range": {
-		  	givenNetworking: `{"nodes": "10.250.0.0/16", "pods": "10.250.0.1/19", "services": "10.250.0.1/19"}`,
-		  	expectedError:   true,
-		  },
-		  "Pods and Services overlaps": {
-		  	givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10.64.0.0/19", "services": "10.64.0.0/16"}`,
-		  	expectedError:   true,
-		  },*/
+		"Invalid pods CIDR": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10abcd/16"}`,
+			expectedError:   true,
+		},
+		"Invalid pods CIDR - wrong IP range": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10.96.0.1/13"}`,
+			expectedError:   true,
+		},
+		"Invalid services CIDR": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "services": "abcd"}`,
+			expectedError:   true,
+		},
+		"Invalid services CIDR - wrong IP range": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "services": "10.104.0.1/13"}`,
+			expectedError:   true,
+		},
+		"Pods and Services overlaps": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10.96.0.0/13", "services": "10.96.0.0/16"}`,
+			expectedError:   true,
+			expectedMessage: "services CIDR 10.96.0.0/16 must not overlap pods CIDR 10.96.0.0/13",
+		},
+		"Valid custom pods and services CIDRs": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10.128.0.0/14", "services": "10.132.0.0/16"}`,
+			expectedError:   false,
+		},
+		"Valid CIDRs in 172.16.0.0/12 and 192.168.0.0/16": {
+			givenNetworking: `{"nodes": "192.168.0.0/22", "pods": "172.16.0.0/14", "services": "172.20.0.0/16"}`,
+			expectedError:   false,
+		},
+		"Pods suffix too big": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "10.128.0.0/25"}`,
+			expectedError:   true,
+			expectedMessage: "the suffix of the pods CIDR 10.128.0.0/25 must not be greater than 24",
+		},
+		"Services suffix too big": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "services": "10.132.0.0/25"}`,
+			expectedError:   true,
+			expectedMessage: "the suffix of the services CIDR 10.132.0.0/25 must not be greater than 24",
+		},
+		"Nodes CIDR not private": {
+			givenNetworking: `{"nodes": "100.64.0.0/22"}`,
+			expectedError:   true,
+			expectedMessage: "the nodes CIDR 100.64.0.0/22 must be within one of the private ranges: 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16",
+		},
+		"Pods CIDR exceeds private range": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "pods": "8.0.0.0/6"}`,
+			expectedError:   true,
+		},
+		"Services overlaps with seed cidr": {
+			givenNetworking: `{"nodes": "10.250.0.0/22", "services": "10.242.0.0/16"}`,
+			expectedError:   true,
+			expectedMessage: "services CIDR 10.242.0.0/16 must not overlap seed CIDR 10.242.0.0/16",
+		},
 		"Pods and Nodes overlaps": {
 			givenNetworking: `{"nodes": "10.96.0.0/16"}`,
 			expectedError:   true,
@@ -1541,6 +1575,7 @@ func TestNetworkingValidation(t *testing.T) {
 		"Suffix too big": {
 			givenNetworking: `{"nodes": "10.250.0.0/25"}`,
 			expectedError:   true,
+			expectedMessage: "the suffix of the nodes CIDR 10.250.0.0/25 must not be greater than 23",
 		},
 	} {
 		t.Run(tn, func(t *testing.T) {
@@ -1587,6 +1622,9 @@ func TestNetworkingValidation(t *testing.T) {
 
 			// then
 			assert.Equal(t, tc.expectedError, err != nil)
+			if tc.expectedMessage != "" {
+				assert.ErrorContains(t, err, tc.expectedMessage)
+			}
 		})
 	}
 
@@ -1743,7 +1781,7 @@ func TestSapConvergedCloudBlocking(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "eu-de-1", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "any-global-account-id", subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -1794,7 +1832,7 @@ func TestSapConvergedCloudBlocking(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "switzerlandnorth", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "any-global-account-id", subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.NoError(t, err)
@@ -1847,7 +1885,7 @@ func TestSapConvergedCloudBlocking(t *testing.T) {
 			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "region": "%s","oidc":{ %s }}`, clusterName, "eu-de-1", oidcParams)),
 			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
 		}, true)
-		t.Logf("%+v\n", *provisionEndpoint)
+		t.Logf("%+v\n", provisionEndpoint)
 
 		// then
 		require.Error(t, err)
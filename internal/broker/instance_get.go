@@ -85,13 +85,13 @@ func (b *GetInstanceEndpoint) GetInstance(_ context.Context, instanceID string,
 	}
 
 	if b.config.ShowTrialExpirationInfo &&
-		instance.ServicePlanID == TrialPlanID &&
+		IsTrialPlan(instance.ServicePlanID) &&
 		(b.config.SubaccountsIdsToShowTrialExpirationInfo == allSubaccountsIDs ||
 			strings.Contains(b.config.SubaccountsIdsToShowTrialExpirationInfo, instance.SubAccountID)) {
 		spec.Metadata.Labels = ResponseLabelsWithExpirationInfo(*op, *instance, b.config.URL, b.config.TrialDocsURL, b.config.EnableKubeconfigURLLabel, trialDocsKey, trialExpireDuration, trialExpiryDetailsKey, trialExpiredInfoFormat, b.kcBuilder)
 	}
 
-	if b.config.ShowFreeExpirationInfo && instance.ServicePlanID == FreemiumPlanID {
+	if b.config.ShowFreeExpirationInfo && IsFreemiumPlan(instance.ServicePlanID) {
 		spec.Metadata.Labels = ResponseLabelsWithExpirationInfo(*op, *instance, b.config.URL, b.config.FreeDocsURL, b.config.EnableKubeconfigURLLabel, freeDocsKey, b.config.FreeExpirationPeriod, freeExpiryDetailsKey, freeExpiredInfoFormat, b.kcBuilder)
 	}
 
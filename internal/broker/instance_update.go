@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/kyma-project/kyma-environment-broker/internal/assuredworkloads"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
@@ -36,6 +38,20 @@ type ContextUpdateHandler interface {
 	Handle(instance *internal.Instance, newCtx internal.ERSContext) (bool, error)
 }
 
+// Attributes of the update response metadata describing how the context and the parameters of the request were applied
+const (
+	UpdateAttributeContext    = "context"
+	UpdateAttributeParameters = "parameters"
+
+	ContextUpdated             = "updated"
+	ContextSuspensionStarted   = "suspension started"
+	ContextUnsuspensionStarted = "unsuspension started"
+
+	ParametersApplied                  = "applied by the update operation"
+	ParametersAppliedAfterUnsuspension = "applied by the update operation after the unsuspension"
+	ParametersAppliedOnUnsuspension    = "stored and applied with the next unsuspension"
+)
+
 type UpdateEndpoint struct {
 	config Config
 	log    logrus.FieldLogger
@@ -52,8 +68,9 @@ type UpdateEndpoint struct {
 
 	updatingQueue Queue
 
-	plansConfig  PlansConfig
-	planDefaults PlanDefaults
+	plansConfig   PlansConfig
+	plansConfigMu sync.RWMutex
+	planDefaults  PlanDefaults
 
 	dashboardConfig dashboard.Config
 	kcBuilder       kubeconfig.KcBuilder
@@ -100,6 +117,19 @@ func NewUpdate(cfg Config,
 	}
 }
 
+// SetPlansConfig replaces the plans configuration read from the catalog file
+func (b *UpdateEndpoint) SetPlansConfig(plansConfig PlansConfig) {
+	b.plansConfigMu.Lock()
+	defer b.plansConfigMu.Unlock()
+	b.plansConfig = plansConfig
+}
+
+func (b *UpdateEndpoint) currentPlansConfig() PlansConfig {
+	b.plansConfigMu.RLock()
+	defer b.plansConfigMu.RUnlock()
+	return b.plansConfig
+}
+
 // Update modifies an existing service instance
 //
 //	PATCH /v2/service_instances/{instance_id}
@@ -116,7 +146,7 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 		logger.Errorf("unable to get instance: %s", err.Error())
 		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to get instance")
 	}
-	logger.Infof("Plan ID/Name: %s/%s", instance.ServicePlanID, PlanNamesMapping[instance.ServicePlanID])
+	logger.Infof("Plan ID/Name: %s/%s", instance.ServicePlanID, PlanNameForID(instance.ServicePlanID))
 	var ersContext internal.ERSContext
 	err = json.Unmarshal(details.RawContext, &ersContext)
 	if err != nil {
@@ -125,6 +155,12 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}
 	logger.Infof("Global account ID: %s active: %s", instance.GlobalAccountID, ptr.BoolAsString(ersContext.Active))
 	logger.Infof("Received context: %s", marshallRawContext(hideSensitiveDataFromRawContext(details.RawContext)))
+	if details.PlanID != "" && details.PlanID != instance.ServicePlanID {
+		if !b.config.PlanUpgrades.Allowed(instance.ServicePlanID, details.PlanID) {
+			logger.Warnf("plan change from %s to %s is not allowed", PlanNameForID(instance.ServicePlanID), PlanNameForID(details.PlanID))
+			return domain.UpdateServiceSpec{}, apiresponses.ErrPlanChangeNotSupported
+		}
+	}
 	// validation of incoming input
 	if err := b.validateWithJsonSchemaValidator(details, instance); err != nil {
 		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "validation failed")
@@ -165,16 +201,38 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}
 
 	if b.processingEnabled {
+		// parameters are validated before the context is processed, so an invalid request does not change the suspension state
+		request, err := b.prepareUpdateParameters(instance, details, asyncAllowed, ersContext, logger)
+		if err != nil {
+			return domain.UpdateServiceSpec{}, err
+		}
+
 		instance, suspendStatusChange, err := b.processContext(instance, details, lastProvisioningOperation, logger)
 		if err != nil {
 			return domain.UpdateServiceSpec{}, err
 		}
+		contextApplied := contextAppliedAttribute(instance, suspendStatusChange)
 
-		// NOTE: KEB currently can't process update parameters in one call along with context update
-		// this block makes it that KEB ignores any parameters updates if context update changed suspension state
-		if !suspendStatusChange && !instance.IsExpired() {
-			return b.processUpdateParameters(instance, details, lastProvisioningOperation, asyncAllowed, ersContext, logger)
+		// the suspension state change already applies the ERS context, the update operation is needed only for parameters or a plan change
+		if request != nil && (!suspendStatusChange || len(details.RawParameters) != 0 || request.planChange) {
+			if !asyncAllowed {
+				return domain.UpdateServiceSpec{}, apiresponses.ErrAsyncRequired
+			}
+			if suspendStatusChange && !isActive(instance) {
+				// the suspended runtime cannot be updated, the parameters are provisioned with the next unsuspension
+				return b.storeUpdateParameters(instance, request, lastProvisioningOperation, contextApplied, logger)
+			}
+			return b.processUpdateParameters(instance, request, lastProvisioningOperation, contextApplied, suspendStatusChange, logger)
 		}
+		return domain.UpdateServiceSpec{
+			IsAsync:       false,
+			DashboardURL:  dashboardURL,
+			OperationData: "",
+			Metadata: domain.InstanceMetadata{
+				Labels:     ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel, b.kcBuilder),
+				Attributes: map[string]string{UpdateAttributeContext: contextApplied},
+			},
+		}, nil
 	}
 	return domain.UpdateServiceSpec{
 		IsAsync:       false,
@@ -186,9 +244,36 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}, nil
 }
 
+func contextAppliedAttribute(instance *internal.Instance, suspendStatusChange bool) string {
+	switch {
+	case !suspendStatusChange:
+		return ContextUpdated
+	case isActive(instance):
+		return ContextUnsuspensionStarted
+	default:
+		return ContextSuspensionStarted
+	}
+}
+
+func parametersAppliedAttribute(suspendStatusChange bool) string {
+	if suspendStatusChange {
+		return ParametersAppliedAfterUnsuspension
+	}
+	return ParametersApplied
+}
+
+func isActive(instance *internal.Instance) bool {
+	active := instance.Parameters.ErsContext.Active
+	return active != nil && *active
+}
+
 func (b *UpdateEndpoint) validateWithJsonSchemaValidator(details domain.UpdateDetails, instance *internal.Instance) error {
 	if len(details.RawParameters) > 0 {
-		planValidator, err := b.getJsonSchemaValidator(instance.Provider, instance.ServicePlanID, instance.Parameters.PlatformRegion)
+		planID := instance.ServicePlanID
+		if details.PlanID != "" {
+			planID = details.PlanID
+		}
+		planValidator, err := b.getJsonSchemaValidator(instance.Provider, planID, instance.Parameters.PlatformRegion)
 		if err != nil {
 			return fmt.Errorf("while creating plan validator: %w", err)
 		}
@@ -203,35 +288,121 @@ func (b *UpdateEndpoint) validateWithJsonSchemaValidator(details domain.UpdateDe
 	return nil
 }
 
+func isPlanChange(instance *internal.Instance, details domain.UpdateDetails) bool {
+	return details.PlanID != "" && details.PlanID != instance.ServicePlanID
+}
+
+// applyPlanChangeDefaults checks if the target plan runs on the same hyperscaler as the current one.
+// The machine type and autoscaler parameters not provided in the request are set to the defaults of the target plan.
+func (b *UpdateEndpoint) applyPlanChangeDefaults(instance *internal.Instance, planID string, defaults *gqlschema.ClusterConfigInput, params *internal.UpdatingParametersDTO) error {
+	currentDefaults, err := b.planDefaults(instance.ServicePlanID, instance.Provider, &instance.Provider)
+	if err != nil {
+		return fmt.Errorf("unable to obtain defaults of the current plan: %w", err)
+	}
+	if currentDefaults.GardenerConfig == nil || defaults.GardenerConfig == nil {
+		return nil
+	}
+	current, target := currentDefaults.GardenerConfig, defaults.GardenerConfig
+	if current.Provider != target.Provider {
+		return fmt.Errorf("plan %s cannot be changed to %s running on another hyperscaler", PlanNameForID(instance.ServicePlanID), PlanNameForID(planID))
+	}
+	if params.MachineType == nil || *params.MachineType == "" {
+		params.MachineType = ptr.String(target.MachineType)
+	}
+	if params.AutoScalerMin == nil {
+		params.AutoScalerMin = ptr.Integer(target.AutoScalerMin)
+	}
+	if params.AutoScalerMax == nil {
+		params.AutoScalerMax = ptr.Integer(target.AutoScalerMax)
+	}
+	return nil
+}
+
+// validateAdditionalWorkerNodePoolsUpdate checks the new list of pools, zones of an existing pool cannot be changed
+func validateAdditionalWorkerNodePoolsUpdate(current, updated []internal.AdditionalWorkerNodePool) error {
+	if err := internal.ValidateAdditionalWorkerNodePools(updated); err != nil {
+		return err
+	}
+	haZones := make(map[string]bool, len(current))
+	for _, pool := range current {
+		haZones[pool.Name] = pool.HAZones
+	}
+	for _, pool := range updated {
+		if current, exists := haZones[pool.Name]; exists && current != pool.HAZones {
+			return fmt.Errorf("HA zones setting cannot be changed for the existing additional worker node pool %s", pool.Name)
+		}
+	}
+	return nil
+}
+
+// validateVolumeSizeAndZonesUpdate checks if the plan supports changing the volume size and zones, the volume size can only be increased
+// and the multiple zones can only be enabled
+func validateVolumeSizeAndZonesUpdate(currentVolumeSizeGb *int, planID string, params internal.UpdatingParametersDTO) error {
+	if params.VolumeSizeGb == nil && params.MultiZone == nil {
+		return nil
+	}
+	if !IsVolumeSizeAndZonesUpdateSupported(planID) {
+		return fmt.Errorf("volumeSizeGb and multiZone parameters are not supported for the %s plan", PlanNameForID(planID))
+	}
+	if params.VolumeSizeGb != nil {
+		current := currentVolumeSizeGb
+		if current != nil && *params.VolumeSizeGb < *current {
+			return fmt.Errorf("volumeSizeGb cannot be decreased, the current volume size is %d GB", *current)
+		}
+	}
+	if params.MultiZone != nil && !*params.MultiZone {
+		return fmt.Errorf("multiZone can only be enabled, zones cannot be removed from the cluster")
+	}
+	return nil
+}
+
+// currentVolumeSizeGb returns the volume size of the instance, the default volume size of the instance plan is used
+// if the volume size was never provided
+func (b *UpdateEndpoint) currentVolumeSizeGb(instance *internal.Instance) (*int, error) {
+	if instance.Parameters.Parameters.VolumeSizeGb != nil {
+		return instance.Parameters.Parameters.VolumeSizeGb, nil
+	}
+	defaults, err := b.planDefaults(instance.ServicePlanID, instance.Provider, &instance.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if defaults.GardenerConfig == nil {
+		return nil, nil
+	}
+	return defaults.GardenerConfig.VolumeSizeGb, nil
+}
+
 func shouldUpdate(instance *internal.Instance, details domain.UpdateDetails, ersContext internal.ERSContext) bool {
-	if len(details.RawParameters) != 0 {
+	if len(details.RawParameters) != 0 || isPlanChange(instance, details) {
 		return true
 	}
 	return ersContext.ERSUpdate()
 }
 
-func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, asyncAllowed bool, ersContext internal.ERSContext, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
+// updateRequest contains the update parameters validated before the context of the request is processed
+type updateRequest struct {
+	params     internal.UpdatingParametersDTO
+	planID     string
+	planChange bool
+}
+
+// prepareUpdateParameters validates the update parameters and the plan change, nil is returned if there is nothing to update
+func (b *UpdateEndpoint) prepareUpdateParameters(instance *internal.Instance, details domain.UpdateDetails, asyncAllowed bool, ersContext internal.ERSContext, logger logrus.FieldLogger) (*updateRequest, error) {
 	if !shouldUpdate(instance, details, ersContext) {
 		logger.Debugf("Parameters not provided, skipping processing update parameters")
-		return domain.UpdateServiceSpec{
-			IsAsync:       false,
-			DashboardURL:  instance.DashboardURL,
-			OperationData: "",
-			Metadata: domain.InstanceMetadata{
-				Labels: ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel, b.kcBuilder),
-			},
-		}, nil
+		return nil, nil
 	}
 	// asyncAllowed needed, see https://github.com/openservicebrokerapi/servicebroker/blob/v2.16/spec.md#updating-a-service-instance
-	if !asyncAllowed {
-		return domain.UpdateServiceSpec{}, apiresponses.ErrAsyncRequired
+	// a change of the ERS context alone is checked after the context is processed, the suspension state change does not need the update operation
+	if !asyncAllowed && (len(details.RawParameters) != 0 || isPlanChange(instance, details)) {
+		return nil, apiresponses.ErrAsyncRequired
 	}
 	var params internal.UpdatingParametersDTO
 	if len(details.RawParameters) != 0 {
 		err := json.Unmarshal(details.RawParameters, &params)
 		if err != nil {
 			logger.Errorf("unable to unmarshal parameters: %s", err.Error())
-			return domain.UpdateServiceSpec{}, fmt.Errorf("unable to unmarshal parameters")
+			return nil, fmt.Errorf("unable to unmarshal parameters")
 		}
 		logger.Debugf("Updating with params: %+v", params)
 	}
@@ -239,45 +410,149 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.OIDC.IsProvided() {
 		if err := params.OIDC.Validate(); err != nil {
 			logger.Errorf("invalid OIDC parameters: %s", err.Error())
-			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+			return nil, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
 
-	operationID := uuid.New().String()
-	logger = logger.WithField("operationID", operationID)
+	if params.AdditionalWorkerNodePools != nil {
+		if err := validateAdditionalWorkerNodePoolsUpdate(instance.Parameters.Parameters.AdditionalWorkerNodePools, params.AdditionalWorkerNodePools); err != nil {
+			logger.Errorf("invalid additional worker node pools: %s", err.Error())
+			return nil, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
+	if params.MaintenanceWindow != nil {
+		if err := params.MaintenanceWindow.Validate(); err != nil {
+			logger.Errorf("invalid maintenance window: %s", err.Error())
+			return nil, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
 
-	logger.Debugf("creating update operation %v", params)
-	operation := internal.NewUpdateOperation(operationID, instance, params)
 	planID := instance.Parameters.PlanID
 	if len(details.PlanID) != 0 {
 		planID = details.PlanID
 	}
+	var currentVolumeSizeGb *int
+	if params.VolumeSizeGb != nil {
+		var err error
+		currentVolumeSizeGb, err = b.currentVolumeSizeGb(instance)
+		if err != nil {
+			logger.Errorf("unable to obtain the current volume size: %s", err.Error())
+			return nil, fmt.Errorf("unable to obtain plan defaults")
+		}
+	}
+	if err := validateVolumeSizeAndZonesUpdate(currentVolumeSizeGb, planID, params); err != nil {
+		logger.Errorf("invalid volume size or zones: %s", err.Error())
+		return nil, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
 	defaults, err := b.planDefaults(planID, instance.Provider, &instance.Provider)
 	if err != nil {
 		logger.Errorf("unable to obtain plan defaults: %s", err.Error())
-		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to obtain plan defaults")
+		return nil, fmt.Errorf("unable to obtain plan defaults")
+	}
+	planChange := isPlanChange(instance, details)
+	if planChange {
+		if err := b.applyPlanChangeDefaults(instance, planID, defaults, &params); err != nil {
+			logger.Errorf("invalid plan change: %s", err.Error())
+			return nil, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
 	}
+
 	var autoscalerMin, autoscalerMax int
 	if defaults.GardenerConfig != nil {
 		p := defaults.GardenerConfig
 		autoscalerMin, autoscalerMax = p.AutoScalerMin, p.AutoScalerMax
 	}
-	if err := operation.ProvisioningParameters.Parameters.AutoScalerParameters.Validate(autoscalerMin, autoscalerMax); err != nil {
+	parameters := instance.Parameters.Parameters
+	params.UpdateAutoScaler(&parameters)
+	if err := parameters.AutoScalerParameters.Validate(autoscalerMin, autoscalerMax); err != nil {
 		logger.Errorf("invalid autoscaler parameters: %s", err.Error())
-		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+		return nil, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
 	}
-	err = b.operationStorage.InsertOperation(operation)
+
+	return &updateRequest{params: params, planID: planID, planChange: planChange}, nil
+}
+
+// processUpdateParameters creates the update operation. The context of the request is already processed,
+// if the unsuspension was started, the operation waits for the unsuspension to be finished.
+func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, request *updateRequest, lastProvisioningOperation *internal.ProvisioningOperation, contextApplied string, suspendStatusChange bool, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
+	params, planID, planChange := request.params, request.planID, request.planChange
+
+	operationID := uuid.New().String()
+	logger = logger.WithField("operationID", operationID)
+
+	logger.Debugf("creating update operation %v", params)
+	operation := internal.NewUpdateOperation(operationID, instance, params)
+	if planChange {
+		logger.Infof("changing plan from %s to %s", PlanNameForID(instance.ServicePlanID), PlanNameForID(planID))
+		operation.PreviousPlanID = instance.ServicePlanID
+		operation.ProvisioningParameters.PlanID = planID
+	}
+	parametersApplied := parametersAppliedAttribute(suspendStatusChange)
+	if suspendStatusChange {
+		operation.Description = fmt.Sprintf("Operation created, parameters %s", parametersApplied)
+	}
+	err := b.operationStorage.InsertOperation(operation)
+	if err != nil {
+		return domain.UpdateServiceSpec{}, err
+	}
+
+	instance, err = b.updateInstanceParameters(instance, request, logger)
+	if err != nil {
+		return domain.UpdateServiceSpec{}, err
+	}
+	logger.Debugf("Adding update operation to the processing queue")
+	b.updatingQueue.Add(operationID)
+
+	return domain.UpdateServiceSpec{
+		IsAsync:       true,
+		DashboardURL:  instance.DashboardURL,
+		OperationData: operation.ID,
+		Metadata: domain.InstanceMetadata{
+			Labels: ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel, b.kcBuilder),
+			Attributes: map[string]string{
+				UpdateAttributeContext:    contextApplied,
+				UpdateAttributeParameters: parametersApplied,
+			},
+		},
+	}, nil
+}
+
+// storeUpdateParameters stores the parameters of the instance being suspended without creating the update operation,
+// the unsuspension provisions the runtime with the stored parameters
+func (b *UpdateEndpoint) storeUpdateParameters(instance *internal.Instance, request *updateRequest, lastProvisioningOperation *internal.ProvisioningOperation, contextApplied string, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
+	instance, err := b.updateInstanceParameters(instance, request, logger)
 	if err != nil {
 		return domain.UpdateServiceSpec{}, err
 	}
 
+	return domain.UpdateServiceSpec{
+		IsAsync:       false,
+		DashboardURL:  instance.DashboardURL,
+		OperationData: "",
+		Metadata: domain.InstanceMetadata{
+			Labels: ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel, b.kcBuilder),
+			Attributes: map[string]string{
+				UpdateAttributeContext:    contextApplied,
+				UpdateAttributeParameters: ParametersAppliedOnUnsuspension,
+			},
+		},
+	}, nil
+}
+
+// updateInstanceParameters stores the parameters of the update request in the instance
+func (b *UpdateEndpoint) updateInstanceParameters(instance *internal.Instance, request *updateRequest, logger logrus.FieldLogger) (*internal.Instance, error) {
+	params, planID, planChange := request.params, request.planID, request.planChange
+
+	var err error
 	var updateStorage []string
 	if params.OIDC.IsProvided() {
 		instance.Parameters.Parameters.OIDC = params.OIDC
 		updateStorage = append(updateStorage, "OIDC")
 	}
 
-	if len(params.RuntimeAdministrators) != 0 {
+	// an explicit empty list resets the administrators to the default one taken from the user_id
+	if params.RuntimeAdministrators != nil {
 		newAdministrators := make([]string, 0, len(params.RuntimeAdministrators))
 		newAdministrators = append(newAdministrators, params.RuntimeAdministrators...)
 		instance.Parameters.Parameters.RuntimeAdministrators = newAdministrators
@@ -290,6 +565,26 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.MachineType != nil && *params.MachineType != "" {
 		instance.Parameters.Parameters.MachineType = params.MachineType
 	}
+	if params.UpdateAdditionalWorkerNodePools(&instance.Parameters.Parameters) {
+		updateStorage = append(updateStorage, "Additional Worker Node Pools")
+	}
+	if params.UpdateVolumeSizeAndZones(&instance.Parameters.Parameters) {
+		updateStorage = append(updateStorage, "Volume Size and Zones")
+	}
+	if params.Modules != nil {
+		instance.Parameters.Parameters.Modules = params.Modules
+		updateStorage = append(updateStorage, "Modules")
+	}
+	if params.MaintenanceWindow != nil {
+		instance.Parameters.Parameters.MaintenanceWindow = params.MaintenanceWindow
+		updateStorage = append(updateStorage, "Maintenance Window")
+	}
+	if planChange {
+		instance.ServicePlanID = planID
+		instance.ServicePlanName = PlanNameForID(planID)
+		instance.Parameters.PlanID = planID
+		updateStorage = append(updateStorage, "Service Plan")
+	}
 	if len(updateStorage) > 0 {
 		if err := wait.PollImmediate(500*time.Millisecond, 2*time.Second, func() (bool, error) {
 			instance, err = b.instanceStorage.Update(*instance)
@@ -300,21 +595,10 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 			}
 			return true, nil
 		}); err != nil {
-			response := apiresponses.NewFailureResponse(fmt.Errorf("Update operation failed"), http.StatusInternalServerError, err.Error())
-			return domain.UpdateServiceSpec{}, response
+			return nil, apiresponses.NewFailureResponse(fmt.Errorf("Update operation failed"), http.StatusInternalServerError, err.Error())
 		}
 	}
-	logger.Debugf("Adding update operation to the processing queue")
-	b.updatingQueue.Add(operationID)
-
-	return domain.UpdateServiceSpec{
-		IsAsync:       true,
-		DashboardURL:  instance.DashboardURL,
-		OperationData: operation.ID,
-		Metadata: domain.InstanceMetadata{
-			Labels: ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel, b.kcBuilder),
-		},
-	}, nil
+	return instance, nil
 }
 
 func (b *UpdateEndpoint) processContext(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, logger logrus.FieldLogger) (*internal.Instance, bool, error) {
@@ -402,7 +686,7 @@ func (b *UpdateEndpoint) extractActiveValue(id string, provisioning internal.Pro
 func (b *UpdateEndpoint) getJsonSchemaValidator(provider internal.CloudProvider, planID string, platformRegion string) (JSONSchemaValidator, error) {
 	// shootAndSeedSameRegion is never enabled for update
 	b.log.Printf("region is: %s", platformRegion)
-	plans := Plans(b.plansConfig, provider, b.config.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion), b.config.UseSmallerMachineTypes, false, b.convergedCloudRegionsProvider.GetRegions(platformRegion), assuredworkloads.IsKSA(platformRegion))
+	plans := Plans(b.currentPlansConfig(), provider, b.config.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion), b.config.UseSmallerMachineTypes, false, b.convergedCloudRegionsProvider.GetRegions(platformRegion), assuredworkloads.IsKSA(platformRegion))
 	plan := plans[planID]
 	schema := string(Marshal(plan.Schemas.Instance.Update.Parameters))
 
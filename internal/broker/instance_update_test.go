@@ -318,6 +318,217 @@ func TestUpdateEndpoint_UpdateUnsuspension(t *testing.T) {
 	assert.False(t, *handler.Instance.Parameters.ErsContext.Active)
 }
 
+type suspensionStateHandler struct {
+	handler
+}
+
+func (h *suspensionStateHandler) Handle(inst *internal.Instance, ers internal.ERSContext) (bool, error) {
+	h.handler.Handle(inst, ers)
+	return true, nil
+}
+
+func TestUpdateEndpoint_UpdateUnsuspensionWithParameters(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = TrialPlanID
+	instance.Parameters.PlanID = TrialPlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+	require.NoError(t, err)
+	err = st.Operations().InsertDeprovisioningOperation(fixSuspensionOperation())
+	require.NoError(t, err)
+
+	handler := &suspensionStateHandler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	kcBuilder.On("GetServerURL", "").Return("", fmt.Errorf("error"))
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, true, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	// when
+	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:        TrialPlanID,
+		RawParameters: json.RawMessage(`{"administrators":["newAdmin1@kyma.cx"]}`),
+		RawContext:    json.RawMessage("{\"active\":true}"),
+	}, true)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, response.IsAsync)
+	assert.Equal(t, map[string]string{
+		UpdateAttributeContext:    ContextUnsuspensionStarted,
+		UpdateAttributeParameters: ParametersAppliedAfterUnsuspension,
+	}, response.Metadata.Attributes)
+	assert.Equal(t, internal.ERSContext{Active: ptr.Bool(true)}, handler.ersContext)
+
+	operation, err := st.Operations().GetOperationByID(response.OperationData)
+	require.NoError(t, err)
+	assert.Equal(t, internal.OperationTypeUpdate, operation.Type)
+	assert.Equal(t, []string{"newAdmin1@kyma.cx"}, operation.UpdatingParameters.RuntimeAdministrators)
+
+	inst, err := st.Instances().GetByID(instanceID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"newAdmin1@kyma.cx"}, inst.Parameters.Parameters.RuntimeAdministrators)
+	assert.True(t, *inst.Parameters.ErsContext.Active)
+}
+
+func TestUpdateEndpoint_UpdateSuspensionWithParameters(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = TrialPlanID
+	instance.Parameters.PlanID = TrialPlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+	require.NoError(t, err)
+
+	handler := &suspensionStateHandler{}
+	q := &automock.Queue{}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	kcBuilder.On("GetServerURL", "").Return("", fmt.Errorf("error"))
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, true, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	// when
+	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:        TrialPlanID,
+		RawParameters: json.RawMessage(`{"administrators":["newAdmin1@kyma.cx"]}`),
+		RawContext:    json.RawMessage("{\"active\":false}"),
+	}, true)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, response.IsAsync)
+	assert.Empty(t, response.OperationData)
+	assert.Equal(t, map[string]string{
+		UpdateAttributeContext:    ContextSuspensionStarted,
+		UpdateAttributeParameters: ParametersAppliedOnUnsuspension,
+	}, response.Metadata.Attributes)
+	q.AssertNotCalled(t, "Add", mock.Anything)
+
+	operations, err := st.Operations().ListOperationsByInstanceID(instanceID)
+	require.NoError(t, err)
+	for _, op := range operations {
+		assert.NotEqual(t, internal.OperationTypeUpdate, op.Type, "the update operation must not race the suspension")
+	}
+
+	inst, err := st.Instances().GetByID(instanceID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"newAdmin1@kyma.cx"}, inst.Parameters.Parameters.RuntimeAdministrators)
+	assert.False(t, *inst.Parameters.ErsContext.Active)
+}
+
+func TestUpdateEndpoint_UpdateSuspensionWithContextChangeWithoutAsync(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = TrialPlanID
+	instance.Parameters.PlanID = TrialPlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+	require.NoError(t, err)
+
+	handler := &suspensionStateHandler{}
+	q := &automock.Queue{}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	kcBuilder.On("GetServerURL", "").Return("", fmt.Errorf("error"))
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, true, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	// when
+	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:     TrialPlanID,
+		RawContext: json.RawMessage(`{"active":false,"license_type":"CUSTOMER"}`),
+	}, false)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, response.IsAsync)
+	assert.Equal(t, map[string]string{UpdateAttributeContext: ContextSuspensionStarted}, response.Metadata.Attributes)
+	q.AssertNotCalled(t, "Add", mock.Anything)
+
+	inst, err := st.Instances().GetByID(instanceID)
+	require.NoError(t, err)
+	assert.False(t, *inst.Parameters.ErsContext.Active)
+	assert.Equal(t, "CUSTOMER", *inst.Parameters.ErsContext.LicenseType)
+}
+
+func TestUpdateEndpoint_UpdateContextChangeWithoutAsync(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = TrialPlanID
+	instance.Parameters.PlanID = TrialPlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+	require.NoError(t, err)
+
+	q := &automock.Queue{}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, true, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, &kcMock.KcBuilder{}, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	// when
+	_, err = svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:     TrialPlanID,
+		RawContext: json.RawMessage(`{"license_type":"CUSTOMER"}`),
+	}, false)
+
+	// then
+	assert.ErrorIs(t, err, apiresponses.ErrAsyncRequired)
+	q.AssertNotCalled(t, "Add", mock.Anything)
+}
+
+func TestUpdateEndpoint_UpdateUnsuspensionWithInvalidParameters(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = TrialPlanID
+	instance.Parameters.PlanID = TrialPlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+	require.NoError(t, err)
+	err = st.Operations().InsertDeprovisioningOperation(fixSuspensionOperation())
+	require.NoError(t, err)
+
+	handler := &suspensionStateHandler{}
+	q := &automock.Queue{}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, true, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, &kcMock.KcBuilder{}, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	// when
+	_, err = svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:        TrialPlanID,
+		RawParameters: json.RawMessage(`{"oidc":{"clientID":"client-id"}}`),
+		RawContext:    json.RawMessage("{\"active\":true}"),
+	}, true)
+
+	// then
+	require.Error(t, err)
+	assert.Nil(t, handler.ersContext.Active, "the suspension state must not be changed by an invalid request")
+}
+
 func TestUpdateEndpoint_UpdateInstanceWithWrongActiveValue(t *testing.T) {
 	// given
 	instance := internal.Instance{
@@ -588,6 +799,347 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 	})
 }
 
+func TestUpdateEndpoint_UpdateAdministrators(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.Parameters.Parameters.RuntimeAdministrators = []string{"admin1@test.com", "admin2@test.com"}
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+	require.NoError(t, err)
+
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, true, false, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	t.Run("Should keep the administrators if the parameter is not provided", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        instance.ServicePlanID,
+			RawParameters: json.RawMessage(`{"autoScalerMax":5}`),
+			RawContext:    json.RawMessage(`{"active":true}`),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admin1@test.com", "admin2@test.com"}, updated.Parameters.Parameters.RuntimeAdministrators)
+	})
+
+	t.Run("Should reset the administrators when an empty list is provided", func(t *testing.T) {
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        instance.ServicePlanID,
+			RawParameters: json.RawMessage(`{"administrators":[]}`),
+			RawContext:    json.RawMessage(`{"active":true}`),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Empty(t, updated.Parameters.Parameters.RuntimeAdministrators)
+
+		operation, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Empty(t, operation.ProvisioningParameters.Parameters.RuntimeAdministrators)
+	})
+}
+
+func TestUpdateEndpoint_UpdateAdditionalWorkerNodePools(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.Parameters.Parameters.AdditionalWorkerNodePools = []internal.AdditionalWorkerNodePool{
+		{Name: "name-1", MachineType: "Standard_D2s_v5", HAZones: true, AutoScalerMin: 3, AutoScalerMax: 20},
+	}
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+	require.NoError(t, err)
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, false, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	t.Run("Should fail when HA zones of an existing pool are changed", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"additionalWorkerNodePools":[{"name":"name-1","machineType":"Standard_D2s_v5","haZones":false,"autoScalerMin":1,"autoScalerMax":20}]}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("Should fail when autoScalerMin is negative", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"additionalWorkerNodePools":[{"name":"name-2","machineType":"Standard_D4s_v5","haZones":false,"autoScalerMin":-1,"autoScalerMax":5}]}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "autoScalerMin -1 must not be negative")
+	})
+
+	t.Run("Should fail when the name is not valid", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"additionalWorkerNodePools":[{"name":"Name_2","machineType":"Standard_D4s_v5","haZones":false,"autoScalerMin":0,"autoScalerMax":5}]}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `additional worker node pool name "Name_2"`)
+	})
+
+	t.Run("Should replace additional worker node pools", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"additionalWorkerNodePools":[{"name":"name-2","machineType":"Standard_D4s_v5","haZones":false,"autoScalerMin":0,"autoScalerMax":5}]}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, []internal.AdditionalWorkerNodePool{
+			{Name: "name-2", MachineType: "Standard_D4s_v5", HAZones: false, AutoScalerMin: 0, AutoScalerMax: 5},
+		}, updated.Parameters.Parameters.AdditionalWorkerNodePools)
+	})
+}
+
+func TestUpdateEndpoint_UpdateVolumeSizeAndZones(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.Parameters.Parameters.VolumeSizeGb = ptr.Integer(100)
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+	require.NoError(t, err)
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, false, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	for name, tc := range map[string]struct {
+		parameters      string
+		expectedMessage string
+	}{
+		"Should fail when the volume size is decreased": {
+			parameters:      `{"volumeSizeGb":90}`,
+			expectedMessage: "volumeSizeGb cannot be decreased, the current volume size is 100 GB",
+		},
+		"Should fail when multiple zones are disabled": {
+			parameters:      `{"multiZone":false}`,
+			expectedMessage: "multiZone can only be enabled, zones cannot be removed from the cluster",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+				PlanID:        AzurePlanID,
+				RawParameters: json.RawMessage(tc.parameters),
+				RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			}, true)
+
+			// then
+			require.Error(t, err)
+			require.IsType(t, &apiresponses.FailureResponse{}, err)
+			apierr := err.(*apiresponses.FailureResponse)
+			assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+			assert.EqualError(t, err, tc.expectedMessage)
+		})
+	}
+
+	t.Run("Should store the increased volume size and multiple zones", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"volumeSizeGb":150,"multiZone":true}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.Integer(150), updated.Parameters.Parameters.VolumeSizeGb)
+		assert.Equal(t, ptr.Bool(true), updated.Parameters.Parameters.MultiZone)
+	})
+}
+
+func TestUpdateEndpoint_UpdateVolumeSizeWithoutStoredVolumeSize(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.Parameters.Parameters.VolumeSizeGb = nil
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+	require.NoError(t, err)
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{GardenerConfig: &gqlschema.GardenerConfigInput{VolumeSizeGb: ptr.Integer(120)}}, nil
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, false, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+	t.Run("Should fail when the volume size is lower than the plan default", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"volumeSizeGb":100}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, "volumeSizeGb cannot be decreased, the current volume size is 120 GB")
+	})
+
+	t.Run("Should store the volume size greater than the plan default", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"volumeSizeGb":150}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.Integer(150), updated.Parameters.Parameters.VolumeSizeGb)
+	})
+}
+
+func TestUpdateEndpoint_UpdatePlan(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.ServicePlanID = AzureLitePlanID
+	instance.ServicePlanName = AzureLitePlanName
+	instance.Parameters.PlanID = AzureLitePlanID
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(instance)
+	require.NoError(t, err)
+	err = st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+	require.NoError(t, err)
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		switch planID {
+		case AzureLitePlanID:
+			return &gqlschema.ClusterConfigInput{GardenerConfig: &gqlschema.GardenerConfigInput{Provider: "azure", MachineType: "Standard_D4s_v5", AutoScalerMin: 2, AutoScalerMax: 10}}, nil
+		case AzurePlanID:
+			return &gqlschema.ClusterConfigInput{GardenerConfig: &gqlschema.GardenerConfigInput{Provider: "azure", MachineType: "Standard_D2s_v5", AutoScalerMin: 3, AutoScalerMax: 20}}, nil
+		default:
+			return &gqlschema.ClusterConfigInput{GardenerConfig: &gqlschema.GardenerConfigInput{Provider: "aws", MachineType: "m6i.large", AutoScalerMin: 3, AutoScalerMax: 20}}, nil
+		}
+	}
+	kcBuilder := &kcMock.KcBuilder{}
+	kcBuilder.On("GetServerURL", mock.Anything).Return("", fmt.Errorf("error"))
+	cfg := Config{PlanUpgrades: PlanUpgrades{AzureLitePlanName: {AzurePlanName, AWSPlanName}}}
+	svc := NewUpdate(cfg, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, false, q, PlansConfig{},
+		planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+	rawContext := json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}")
+
+	t.Run("Should reject a plan change which is not configured", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{PlanID: GCPPlanID, RawContext: rawContext}, true)
+
+		// then
+		assert.Equal(t, apiresponses.ErrPlanChangeNotSupported, err)
+	})
+
+	t.Run("Should reject a plan change to another hyperscaler", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{PlanID: AWSPlanID, RawContext: rawContext}, true)
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("Should change the plan", func(t *testing.T) {
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{PlanID: AzurePlanID, RawContext: rawContext}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, AzurePlanID, updated.ServicePlanID)
+		assert.Equal(t, AzurePlanName, updated.ServicePlanName)
+		assert.Equal(t, AzurePlanID, updated.Parameters.PlanID)
+		assert.Equal(t, "Standard_D2s_v5", *updated.Parameters.Parameters.MachineType)
+		assert.Equal(t, 3, *updated.Parameters.Parameters.AutoScalerMin)
+		assert.Equal(t, 20, *updated.Parameters.Parameters.AutoScalerMax)
+
+		operation, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, AzureLitePlanID, operation.PreviousPlanID)
+		assert.Equal(t, AzurePlanID, operation.ProvisioningParameters.PlanID)
+		assert.Equal(t, "Standard_D2s_v5", *operation.UpdatingParameters.MachineType)
+	})
+
+	t.Run("Should reject the plan change if the plan cannot be changed", func(t *testing.T) {
+		// given
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, false, q, PlansConfig{},
+			planDefaults, logrus.New(), dashboardConfig, kcBuilder, &OneForAllConvergedCloudRegionsProvider{}, fakeKcpK8sClient)
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{PlanID: GCPPlanID, RawContext: rawContext}, true)
+
+		// then
+		assert.ErrorIs(t, err, apiresponses.ErrPlanChangeNotSupported)
+		updated, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, AzurePlanID, updated.ServicePlanID)
+	})
+}
+
 func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	// given
 	instance := internal.Instance{
@@ -35,12 +35,12 @@ func (c *KimConfig) IsDrivenByKimOnly(planName string) bool {
 }
 
 func (c *KimConfig) IsPlanIdDrivenByKimOnly(planID string) bool {
-	planName := PlanIDsMapping[planID]
+	planName := PlanNameForID(planID)
 	return c.IsDrivenByKimOnly(planName)
 }
 
 func (c *KimConfig) IsPlanIdDrivenByKim(planID string) bool {
-	planName := PlanIDsMapping[planID]
+	planName := PlanNameForID(planID)
 	return c.IsDrivenByKim(planName)
 }
 
@@ -49,6 +49,6 @@ func (c *KimConfig) IsDrivenByKim(planName string) bool {
 }
 
 func (c *KimConfig) IsEnabledForPlanID(planID string) bool {
-	planName := PlanIDsMapping[planID]
+	planName := PlanNameForID(planID)
 	return c.IsEnabledForPlan(planName)
 }
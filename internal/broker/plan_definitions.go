@@ -0,0 +1,303 @@
+package broker
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"gopkg.in/yaml.v2"
+)
+
+// PlanDefinition describes a plan which is not compiled into the broker but loaded from the plans definitions file.
+// Every definition is based on one of the built-in plans, which determines the hyperscaler, the schema shape,
+// the provisioning input and the behaviour of the plan predicates (IsTrialPlan, IsAzurePlan etc.).
+type PlanDefinition struct {
+	ID       string `yaml:"id"`
+	Name     string `yaml:"name"`
+	BasePlan string `yaml:"basePlan"`
+
+	// Regions and MachineTypes narrow down or replace the lists offered by the base plan, empty means the base plan values
+	Regions      []string `yaml:"regions"`
+	MachineTypes []string `yaml:"machineTypes"`
+
+	DefaultMachineType   string `yaml:"defaultMachineType"`
+	DefaultAutoScalerMin int    `yaml:"defaultAutoScalerMin"`
+	DefaultAutoScalerMax int    `yaml:"defaultAutoScalerMax"`
+}
+
+type PlanDefinitions []PlanDefinition
+
+// hyperscalerBasePlans lists the built-in plans for which regions, machine types and autoscaler defaults can be customized
+var hyperscalerBasePlans = map[string]struct{}{
+	AWSPlanName:               {},
+	PreviewPlanName:           {},
+	AzurePlanName:             {},
+	AzureLitePlanName:         {},
+	GCPPlanName:               {},
+	SapConvergedCloudPlanName: {},
+}
+
+var (
+	planDefinitionsMu sync.RWMutex
+	planDefinitions   = map[string]PlanDefinition{}
+)
+
+func NewPlanDefinitionsFromFile(path string) (PlanDefinitions, error) {
+	yamlFile, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("while reading YAML file with plans definitions: %w", err)
+	}
+	var definitions struct {
+		Plans PlanDefinitions `yaml:"plans"`
+	}
+	err = yaml.UnmarshalStrict(yamlFile, &definitions)
+	if err != nil {
+		return nil, fmt.Errorf("while unmarshaling YAML file with plans definitions: %w", err)
+	}
+	if err := definitions.Plans.Validate(); err != nil {
+		return nil, fmt.Errorf("while validating plans definitions: %w", err)
+	}
+	return definitions.Plans, nil
+}
+
+// Validate checks if the definitions do not collide with each other nor with the built-in plans
+func (d PlanDefinitions) Validate() error {
+	ids := map[string]struct{}{}
+	names := map[string]struct{}{}
+	for _, def := range d {
+		if def.ID == "" || def.Name == "" {
+			return fmt.Errorf("plan definition must contain id and name")
+		}
+		if _, builtIn := builtInPlanNames[def.ID]; builtIn {
+			return fmt.Errorf("plan %s: id %s is already used by the built-in plan %s", def.Name, def.ID, builtInPlanNames[def.ID])
+		}
+		if _, builtIn := builtInPlanIDs[def.Name]; builtIn || def.Name == AllPlansSelector {
+			return fmt.Errorf("plan %s: name is reserved", def.Name)
+		}
+		if _, exists := ids[def.ID]; exists {
+			return fmt.Errorf("plan %s: duplicated id %s", def.Name, def.ID)
+		}
+		if _, exists := names[def.Name]; exists {
+			return fmt.Errorf("duplicated plan name %s", def.Name)
+		}
+		ids[def.ID] = struct{}{}
+		names[def.Name] = struct{}{}
+
+		if _, exists := builtInPlanIDs[def.BasePlan]; !exists {
+			return fmt.Errorf("plan %s: unknown base plan %q", def.Name, def.BasePlan)
+		}
+		_, customizable := hyperscalerBasePlans[def.BasePlan]
+		if !customizable && (len(def.Regions) > 0 || len(def.MachineTypes) > 0 || def.DefaultMachineType != "" || def.DefaultAutoScalerMin > 0 || def.DefaultAutoScalerMax > 0) {
+			return fmt.Errorf("plan %s: regions, machine types and autoscaler defaults cannot be customized for the %s base plan", def.Name, def.BasePlan)
+		}
+		if def.BasePlan == SapConvergedCloudPlanName && len(def.Regions) > 0 {
+			return fmt.Errorf("plan %s: regions of the %s base plan are defined by the region mappings", def.Name, def.BasePlan)
+		}
+		if def.DefaultMachineType != "" && len(def.MachineTypes) > 0 && !contains(def.MachineTypes, def.DefaultMachineType) {
+			return fmt.Errorf("plan %s: default machine type %s is not one of the plan machine types", def.Name, def.DefaultMachineType)
+		}
+		if customizable {
+			baseRegions, baseMachineTypes := basePlanOffering(def.BasePlan)
+			for _, region := range def.Regions {
+				if !contains(baseRegions, region) {
+					return fmt.Errorf("plan %s: region %s is not offered by the %s base plan", def.Name, region, def.BasePlan)
+				}
+			}
+			for _, machineType := range def.MachineTypes {
+				if !contains(baseMachineTypes, machineType) {
+					return fmt.Errorf("plan %s: machine type %s is not offered by the %s base plan", def.Name, machineType, def.BasePlan)
+				}
+			}
+			if def.DefaultMachineType != "" && !contains(baseMachineTypes, def.DefaultMachineType) {
+				return fmt.Errorf("plan %s: default machine type %s is not offered by the %s base plan", def.Name, def.DefaultMachineType, def.BasePlan)
+			}
+		}
+		if def.DefaultAutoScalerMin < 0 || def.DefaultAutoScalerMax < 0 {
+			return fmt.Errorf("plan %s: autoscaler defaults must not be negative", def.Name)
+		}
+		if def.DefaultAutoScalerMin > 0 && def.DefaultAutoScalerMax > 0 && def.DefaultAutoScalerMin > def.DefaultAutoScalerMax {
+			return fmt.Errorf("plan %s: default autoscaler minimum %d is greater than maximum %d", def.Name, def.DefaultAutoScalerMin, def.DefaultAutoScalerMax)
+		}
+	}
+	return nil
+}
+
+// basePlanOffering returns all regions (including the restricted ones) and machine types the base plan can offer
+func basePlanOffering(basePlan string) ([]string, []string) {
+	var hyperscaler string
+	var machineTypes []string
+	switch basePlan {
+	case AWSPlanName, PreviewPlanName:
+		hyperscaler, machineTypes = regions.AWS, AwsMachinesNames()
+	case AzurePlanName:
+		hyperscaler, machineTypes = regions.Azure, AzureMachinesNames()
+	case AzureLitePlanName:
+		hyperscaler, machineTypes = regions.Azure, AzureLiteMachinesNames()
+	case GCPPlanName:
+		hyperscaler, machineTypes = regions.GCP, GcpMachinesNames()
+	case SapConvergedCloudPlanName:
+		return nil, SapConvergedCloudMachinesNames()
+	default:
+		return nil, nil
+	}
+	var offeredRegions []string
+	offeredRegions = append(offeredRegions, regions.Names(hyperscaler, false, false)...)
+	offeredRegions = append(offeredRegions, regions.Names(hyperscaler, true, false)...)
+	offeredRegions = append(offeredRegions, regions.Names(hyperscaler, false, true)...)
+	return offeredRegions, machineTypes
+}
+
+// RegisterPlanDefinitions replaces the set of plans loaded from the plans definitions file.
+// The plans become visible in PlanNameForID and PlanIDForName, in the catalog and in the plan predicates.
+func RegisterPlanDefinitions(definitions PlanDefinitions) error {
+	if err := definitions.Validate(); err != nil {
+		return err
+	}
+
+	planDefinitionsMu.Lock()
+	defer planDefinitionsMu.Unlock()
+	planMappingsMu.Lock()
+	defer planMappingsMu.Unlock()
+
+	for id, def := range planDefinitions {
+		delete(planNames, id)
+		delete(planIDs, def.Name)
+	}
+	planDefinitions = map[string]PlanDefinition{}
+	for _, def := range definitions {
+		planDefinitions[def.ID] = def
+		planNames[def.ID] = def.Name
+		planIDs[def.Name] = def.ID
+	}
+	return nil
+}
+
+// PlanDefinitionForID returns the definition of a plan loaded from the plans definitions file
+func PlanDefinitionForID(planID string) (PlanDefinition, bool) {
+	planDefinitionsMu.RLock()
+	defer planDefinitionsMu.RUnlock()
+
+	def, found := planDefinitions[planID]
+	return def, found
+}
+
+// BasePlanID returns the ID of the built-in plan the given plan is based on.
+// For built-in plans (and unknown IDs) the given ID is returned.
+func BasePlanID(planID string) string {
+	def, found := PlanDefinitionForID(planID)
+	if !found {
+		return planID
+	}
+	return builtInPlanIDs[def.BasePlan]
+}
+
+func sortedPlanDefinitions() PlanDefinitions {
+	planDefinitionsMu.RLock()
+	defer planDefinitionsMu.RUnlock()
+
+	definitions := make(PlanDefinitions, 0, len(planDefinitions))
+	for _, def := range planDefinitions {
+		definitions = append(definitions, def)
+	}
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	return definitions
+}
+
+// definedServicePlan builds the catalog entry for the plan definition using the base plan schema.
+// When the EU access or assured workloads restriction applies, the restricted regions of the base plan are used.
+func definedServicePlan(def PlanDefinition, plans PlansConfig, base domain.ServicePlan, includeAdditionalParamsInSchema, euAccessRestricted, useSmallerMachineTypes, shootAndSeedFeatureFlag bool, sapConvergedCloudRegions []string, assuredWorkloads bool) domain.ServicePlan {
+	if _, customizable := hyperscalerBasePlans[def.BasePlan]; !customizable {
+		return defaultServicePlan(def.ID, def.Name, plans, &base.Schemas.Instance.Create.Parameters, &base.Schemas.Instance.Update.Parameters)
+	}
+
+	createSchema := definedPlanSchema(def, includeAdditionalParamsInSchema, false, euAccessRestricted, useSmallerMachineTypes, shootAndSeedFeatureFlag, sapConvergedCloudRegions, assuredWorkloads)
+	updateSchema := definedPlanSchema(def, includeAdditionalParamsInSchema, true, euAccessRestricted, useSmallerMachineTypes, shootAndSeedFeatureFlag, sapConvergedCloudRegions, assuredWorkloads)
+	return defaultServicePlan(def.ID, def.Name, plans, createSchema, updateSchema)
+}
+
+func definedPlanSchema(def PlanDefinition, additionalParams, update, euAccessRestricted, useSmallerMachineTypes, shootAndSeedFeatureFlag bool, sapConvergedCloudRegions []string, assuredWorkloads bool) *map[string]interface{} {
+	var machineTypes, regions []string
+	var machineTypesDisplay, regionsDisplay map[string]string
+	restricted := euAccessRestricted
+	shootAndSeedSameRegion := true
+	switch def.BasePlan {
+	case AWSPlanName, PreviewPlanName:
+		machineTypes, machineTypesDisplay = AwsMachinesNames(), AwsMachinesDisplay()
+		regions, regionsDisplay = AWSRegions(euAccessRestricted), AWSRegionsDisplay()
+		shootAndSeedSameRegion = def.BasePlan == AWSPlanName
+	case AzurePlanName, AzureLitePlanName:
+		machineTypes, machineTypesDisplay = AzureMachinesNames(), AzureMachinesDisplay()
+		if def.BasePlan == AzureLitePlanName {
+			machineTypes, machineTypesDisplay = AzureLiteMachinesNames(), AzureLiteMachinesDisplay()
+			if !useSmallerMachineTypes {
+				machineTypes = removeMachinesNamesFromList(machineTypes, "Standard_D2s_v5")
+			}
+		}
+		regions, regionsDisplay = AzureRegions(euAccessRestricted), AzureRegionsDisplay(euAccessRestricted)
+	case GCPPlanName:
+		machineTypes, machineTypesDisplay = GcpMachinesNames(), GcpMachinesDisplay()
+		regions, regionsDisplay = GcpRegions(assuredWorkloads), GcpRegionsDisplay(assuredWorkloads)
+		restricted = assuredWorkloads
+	case SapConvergedCloudPlanName:
+		machineTypes, machineTypesDisplay = SapConvergedCloudMachinesNames(), SapConvergedCloudMachinesDisplay()
+		regions, regionsDisplay = sapConvergedCloudRegions, SapConvergedCloudRegionsDisplay()
+	}
+
+	if len(def.MachineTypes) > 0 {
+		machineTypes = def.MachineTypes
+	}
+	if len(def.Regions) > 0 && !restricted {
+		regions = def.Regions
+	}
+
+	properties := NewProvisioningProperties(filterDisplayNames(machineTypesDisplay, machineTypes), filterDisplayNames(regionsDisplay, regions), machineTypes, regions, update)
+	if def.BasePlan == AzureLitePlanName {
+		properties.AutoScalerMax.Minimum = 2
+		properties.AutoScalerMin.Minimum = 2
+		properties.AutoScalerMax.Maximum = 40
+		if !update {
+			properties.AutoScalerMax.Default = 10
+			properties.AutoScalerMin.Default = 2
+		}
+	}
+	if !update {
+		if def.DefaultAutoScalerMin > 0 {
+			properties.AutoScalerMin.Default = def.DefaultAutoScalerMin
+		}
+		if def.DefaultAutoScalerMax > 0 {
+			properties.AutoScalerMax.Default = def.DefaultAutoScalerMax
+		}
+		if def.DefaultMachineType != "" {
+			properties.MachineType.Default = def.DefaultMachineType
+		}
+	}
+
+	return createSchemaWithProperties(properties, additionalParams, update, requiredSchemaProperties(), shootAndSeedSameRegion, shootAndSeedFeatureFlag)
+}
+
+func filterDisplayNames(display map[string]string, names []string) map[string]string {
+	if display == nil {
+		return nil
+	}
+	filtered := make(map[string]string, len(names))
+	for _, name := range names {
+		if displayName, ok := display[name]; ok {
+			filtered[name] = displayName
+		} else {
+			filtered[name] = name
+		}
+	}
+	return filtered
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
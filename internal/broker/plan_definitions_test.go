@@ -0,0 +1,169 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	awsLargePlanID      = "6aae0ff3-89f7-4f12-86de-51466145422e"
+	trialExtendedPlanID = "4fb0b1e6-e4dc-4b0a-a3fa-2a8f2b3b1d0c"
+)
+
+func TestNewPlanDefinitionsFromFile(t *testing.T) {
+	// when
+	definitions, err := NewPlanDefinitionsFromFile("testdata/plans-definitions.yaml")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+	assert.Equal(t, PlanDefinition{
+		ID:                   awsLargePlanID,
+		Name:                 "aws-large",
+		BasePlan:             AWSPlanName,
+		Regions:              []string{"eu-central-1", "us-east-1"},
+		MachineTypes:         []string{"m6i.2xlarge", "m6i.4xlarge"},
+		DefaultMachineType:   "m6i.2xlarge",
+		DefaultAutoScalerMin: 5,
+		DefaultAutoScalerMax: 30,
+	}, definitions[0])
+	assert.Equal(t, TrialPlanName, definitions[1].BasePlan)
+}
+
+func TestPlanDefinitions_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		definition    PlanDefinition
+		expectedError string
+	}{
+		"missing id": {
+			definition:    PlanDefinition{Name: "custom", BasePlan: AWSPlanName},
+			expectedError: "plan definition must contain id and name",
+		},
+		"built-in plan id": {
+			definition:    PlanDefinition{ID: AWSPlanID, Name: "custom", BasePlan: AWSPlanName},
+			expectedError: "id 361c511f-f939-4621-b228-d0fb79a1fe15 is already used by the built-in plan aws",
+		},
+		"built-in plan name": {
+			definition:    PlanDefinition{ID: "custom-id", Name: GCPPlanName, BasePlan: AWSPlanName},
+			expectedError: "plan gcp: name is reserved",
+		},
+		"unknown base plan": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: "alibaba"},
+			expectedError: `plan custom: unknown base plan "alibaba"`,
+		},
+		"machine types for trial base plan": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: TrialPlanName, MachineTypes: []string{"m6i.large"}},
+			expectedError: "cannot be customized for the trial base plan",
+		},
+		"default machine type outside of machine types": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: AWSPlanName, MachineTypes: []string{"m6i.large"}, DefaultMachineType: "m5.large"},
+			expectedError: "default machine type m5.large is not one of the plan machine types",
+		},
+		"region not offered by the base plan": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: AWSPlanName, Regions: []string{"eu-central-1", "westeurope"}},
+			expectedError: "plan custom: region westeurope is not offered by the aws base plan",
+		},
+		"machine type not offered by the base plan": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: GCPPlanName, MachineTypes: []string{"m6i.large"}},
+			expectedError: "plan custom: machine type m6i.large is not offered by the gcp base plan",
+		},
+		"default machine type not offered by the base plan": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: AzurePlanName, DefaultMachineType: "Standard_X99"},
+			expectedError: "plan custom: default machine type Standard_X99 is not offered by the azure base plan",
+		},
+		"autoscaler minimum greater than maximum": {
+			definition:    PlanDefinition{ID: "custom-id", Name: "custom", BasePlan: GCPPlanName, DefaultAutoScalerMin: 10, DefaultAutoScalerMax: 5},
+			expectedError: "default autoscaler minimum 10 is greater than maximum 5",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := PlanDefinitions{tc.definition}.Validate()
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+
+	t.Run("duplicated names", func(t *testing.T) {
+		// when
+		err := PlanDefinitions{
+			{ID: "id-1", Name: "custom", BasePlan: AWSPlanName},
+			{ID: "id-2", Name: "custom", BasePlan: AzurePlanName},
+		}.Validate()
+
+		// then
+		assert.EqualError(t, err, "duplicated plan name custom")
+	})
+}
+
+func TestRegisterPlanDefinitions(t *testing.T) {
+	// given
+	definitions, err := NewPlanDefinitionsFromFile("testdata/plans-definitions.yaml")
+	require.NoError(t, err)
+
+	// when
+	err = RegisterPlanDefinitions(definitions)
+	t.Cleanup(func() {
+		require.NoError(t, RegisterPlanDefinitions(nil))
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "aws-large", PlanNameForID(awsLargePlanID))
+	id, found := PlanIDForName("trial-extended")
+	assert.True(t, found)
+	assert.Equal(t, trialExtendedPlanID, id)
+	assert.Equal(t, AWSPlanID, BasePlanID(awsLargePlanID))
+	assert.Equal(t, AzurePlanID, BasePlanID(AzurePlanID))
+	assert.True(t, IsTrialPlan(trialExtendedPlanID))
+	assert.False(t, IsTrialPlan(awsLargePlanID))
+	assert.NoError(t, EnablePlans{"aws", "aws-large", "trial-extended"}.Validate())
+
+	t.Run("should include plan definitions in the catalog", func(t *testing.T) {
+		// when
+		plans := Plans(PlansConfig{"aws-large": {Description: "AWS Large"}}, "", false, false, false, false, nil, false)
+
+		// then
+		require.Contains(t, plans, awsLargePlanID)
+		plan := plans[awsLargePlanID]
+		assert.Equal(t, "aws-large", plan.Name)
+		assert.Equal(t, "AWS Large", plan.Description)
+
+		properties := plan.Schemas.Instance.Create.Parameters[PropertiesKey].(map[string]interface{})
+		assert.Equal(t, []interface{}{"eu-central-1", "us-east-1"}, properties["region"].(map[string]interface{})["enum"])
+		machineType := properties["machineType"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"m6i.2xlarge", "m6i.4xlarge"}, machineType["enum"])
+		assert.Equal(t, "m6i.2xlarge", machineType["default"])
+		assert.Equal(t, float64(5), properties["autoScalerMin"].(map[string]interface{})["default"])
+		assert.Equal(t, float64(30), properties["autoScalerMax"].(map[string]interface{})["default"])
+
+		require.Contains(t, plans, trialExtendedPlanID)
+		assert.Equal(t, plans[TrialPlanID].Schemas, plans[trialExtendedPlanID].Schemas)
+	})
+
+	t.Run("should use base plan regions when EU access is restricted", func(t *testing.T) {
+		// when
+		plans := Plans(PlansConfig{}, "", false, true, false, false, nil, false)
+
+		// then
+		properties := plans[awsLargePlanID].Schemas.Instance.Create.Parameters[PropertiesKey].(map[string]interface{})
+		assert.Equal(t, []interface{}{"eu-central-1"}, properties["region"].(map[string]interface{})["enum"])
+	})
+
+	t.Run("should remove previously registered plans", func(t *testing.T) {
+		// when
+		err := RegisterPlanDefinitions(PlanDefinitions{definitions[1]})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, PlanNameForID(awsLargePlanID))
+		_, found := PlanIDForName("aws-large")
+		assert.False(t, found)
+		assert.Equal(t, awsLargePlanID, BasePlanID(awsLargePlanID))
+		assert.Error(t, EnablePlans{"aws-large"}.Validate())
+	})
+}
@@ -0,0 +1,72 @@
+package broker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlanUpgrades lists plan changes allowed in the update request, the key is the name of the current plan
+// and the value contains names of plans the instance can be moved to
+type PlanUpgrades map[string][]string
+
+// Unmarshal provides custom parsing of plan upgrades in the "from:to" format separated by commas, for example "azure_lite:azure,azure_lite:aws".
+// Implements envconfig.Unmarshal interface.
+// The names are checked by Validate, after plans from the plans definitions file are registered.
+func (u *PlanUpgrades) Unmarshal(in string) error {
+	upgrades := PlanUpgrades{}
+	for _, pair := range strings.Split(in, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		from, to, found := strings.Cut(pair, ":")
+		if !found || from == "" || to == "" {
+			return fmt.Errorf("invalid plan upgrade %q, expected format from:to", pair)
+		}
+		upgrades[from] = append(upgrades[from], to)
+	}
+	*u = upgrades
+	return nil
+}
+
+// Validate checks if all plans are known and no plan is upgraded to itself.
+// Trial and freemium instances run in the shared hyperscaler accounts and cannot be moved to another plan.
+func (u PlanUpgrades) Validate() error {
+	for from, targets := range u {
+		fromID, exists := PlanIDForName(from)
+		if !exists {
+			return fmt.Errorf("unrecognized %v plan name in plan upgrades", from)
+		}
+		if IsTrialPlan(fromID) || IsFreemiumPlan(fromID) {
+			return fmt.Errorf("plan %v cannot be upgraded, the instance runs in a shared hyperscaler account", from)
+		}
+		for _, to := range targets {
+			if _, exists := PlanIDForName(to); !exists {
+				return fmt.Errorf("unrecognized %v plan name in plan upgrades", to)
+			}
+			if from == to {
+				return fmt.Errorf("plan %v cannot be upgraded to itself", from)
+			}
+		}
+	}
+	return nil
+}
+
+// Updatable returns true if the plan can be changed to any other plan
+func (u PlanUpgrades) Updatable(planID string) bool {
+	return len(u[PlanNameForID(planID)]) > 0
+}
+
+// Allowed returns true if an instance of the fromPlanID plan can be moved to the toPlanID plan
+func (u PlanUpgrades) Allowed(fromPlanID, toPlanID string) bool {
+	toPlanName := PlanNameForID(toPlanID)
+	if toPlanName == "" {
+		return false
+	}
+	for _, to := range u[PlanNameForID(fromPlanID)] {
+		if to == toPlanName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUpgrades(t *testing.T) {
+	t.Run("should parse plan upgrades", func(t *testing.T) {
+		// given
+		var upgrades PlanUpgrades
+
+		// when
+		err := upgrades.Unmarshal("azure_lite:azure, aws:preview,aws:gcp")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, PlanUpgrades{"azure_lite": {"azure"}, "aws": {"preview", "gcp"}}, upgrades)
+		assert.NoError(t, upgrades.Validate())
+		assert.True(t, upgrades.Allowed(AzureLitePlanID, AzurePlanID))
+		assert.True(t, upgrades.Allowed(AWSPlanID, PreviewPlanID))
+		assert.False(t, upgrades.Allowed(AzurePlanID, AzureLitePlanID))
+		assert.False(t, upgrades.Allowed(AzureLitePlanID, "unknown"))
+		assert.True(t, upgrades.Updatable(AzureLitePlanID))
+		assert.False(t, upgrades.Updatable(AzurePlanID))
+	})
+
+	t.Run("should parse empty plan upgrades", func(t *testing.T) {
+		// given
+		var upgrades PlanUpgrades
+
+		// when
+		err := upgrades.Unmarshal("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, upgrades)
+		assert.False(t, upgrades.Updatable(AzureLitePlanID))
+	})
+
+	t.Run("should reject invalid format", func(t *testing.T) {
+		var upgrades PlanUpgrades
+		assert.Error(t, upgrades.Unmarshal("azure_lite"))
+		assert.Error(t, upgrades.Unmarshal("azure_lite:"))
+	})
+
+	t.Run("should reject unknown plans and upgrades to the same plan", func(t *testing.T) {
+		assert.Error(t, PlanUpgrades{"unknown": {"azure"}}.Validate())
+		assert.Error(t, PlanUpgrades{"azure_lite": {"unknown"}}.Validate())
+		assert.Error(t, PlanUpgrades{"azure": {"azure"}}.Validate())
+	})
+
+	t.Run("should reject upgrades of plans running in shared accounts", func(t *testing.T) {
+		assert.ErrorContains(t, PlanUpgrades{TrialPlanName: {AWSPlanName}}.Validate(), "plan trial cannot be upgraded")
+		assert.ErrorContains(t, PlanUpgrades{FreemiumPlanName: {AzurePlanName}}.Validate(), "plan free cannot be upgraded")
+	})
+}
@@ -2,12 +2,14 @@ package broker
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 
 	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 )
 
 type PlanID string
@@ -36,7 +38,9 @@ const (
 	PreviewPlanName           = "preview"
 )
 
-var PlanNamesMapping = map[string]string{
+// builtInPlanNames and builtInPlanIDs contain the plans compiled into the broker,
+// planNames and planIDs contain also plans registered with RegisterPlanDefinitions
+var builtInPlanNames = map[string]string{
 	GCPPlanID:               GCPPlanName,
 	AWSPlanID:               AWSPlanName,
 	AzurePlanID:             AzurePlanName,
@@ -48,7 +52,7 @@ var PlanNamesMapping = map[string]string{
 	PreviewPlanID:           PreviewPlanName,
 }
 
-var PlanIDsMapping = map[string]string{
+var builtInPlanIDs = map[string]string{
 	AzurePlanName:             AzurePlanID,
 	AWSPlanName:               AWSPlanID,
 	AzureLitePlanName:         AzureLitePlanID,
@@ -60,6 +64,37 @@ var PlanIDsMapping = map[string]string{
 	PreviewPlanName:           PreviewPlanID,
 }
 
+var (
+	planMappingsMu sync.RWMutex
+	planNames      = copyMapping(builtInPlanNames)
+	planIDs        = copyMapping(builtInPlanIDs)
+)
+
+// PlanNameForID returns the name of the plan with the given ID, an empty string if the plan is unknown
+func PlanNameForID(planID string) string {
+	planMappingsMu.RLock()
+	defer planMappingsMu.RUnlock()
+
+	return planNames[planID]
+}
+
+// PlanIDForName returns the ID of the plan with the given name
+func PlanIDForName(planName string) (string, bool) {
+	planMappingsMu.RLock()
+	defer planMappingsMu.RUnlock()
+
+	id, found := planIDs[planName]
+	return id, found
+}
+
+func copyMapping(mapping map[string]string) map[string]string {
+	result := make(map[string]string, len(mapping))
+	for k, v := range mapping {
+		result[k] = v
+	}
+	return result
+}
+
 type TrialCloudRegion string
 
 const (
@@ -78,93 +113,30 @@ type JSONSchemaValidator interface {
 	ValidateString(json string) (jsonschema.ValidationResult, error)
 }
 
+// the lists of regions and machine types are defined in the regions configuration, see internal/regions
+
 func AzureRegions(euRestrictedAccess bool) []string {
-	if euRestrictedAccess {
-		return []string{
-			"switzerlandnorth",
-		}
-	}
-	return []string{
-		"eastus",
-		"centralus",
-		"westus2",
-		"uksouth",
-		"northeurope",
-		"westeurope",
-		"japaneast",
-		"southeastasia",
-		"australiaeast",
-		"brazilsouth",
-	}
+	return regions.Names(regions.Azure, euRestrictedAccess, false)
 }
 
 func AzureRegionsDisplay(euRestrictedAccess bool) map[string]string {
-	if euRestrictedAccess {
-		return map[string]string{
-			"switzerlandnorth": "switzerlandnorth (Switzerland, Zurich)",
-		}
-	}
-	return map[string]string{
-		"eastus":        "eastus (US East, VA)",
-		"centralus":     "centralus (US Central, IA)",
-		"westus2":       "westus2 (US West, WA)",
-		"uksouth":       "uksouth (UK South, London)",
-		"northeurope":   "northeurope (Europe, Ireland)",
-		"westeurope":    "westeurope (Europe, Netherlands)",
-		"japaneast":     "japaneast (Japan, Tokyo)",
-		"southeastasia": "southeastasia (Asia Pacific, Singapore)",
-		"australiaeast": "australiaeast (Australia, Sydney)",
-		"brazilsouth":   "brazilsouth (Brazil, São Paulo)",
-	}
+	return regions.DisplayNames(regions.Azure, euRestrictedAccess, false)
 }
 
 func GcpRegions(assuredWorkloads bool) []string {
-	if assuredWorkloads {
-		return []string{
-			"me-central2",
-		}
-	}
-	return []string{
-		"europe-west3",
-		"asia-south1",
-		"us-central1",
-		"me-central2",
-		"asia-northeast2",
-		"me-west1",
-		"southamerica-east1",
-		"australia-southeast1",
-	}
+	return regions.Names(regions.GCP, false, assuredWorkloads)
 }
 
 func GcpRegionsDisplay(assuredWorkloads bool) map[string]string {
-	if assuredWorkloads {
-		return map[string]string{
-			"me-central2": "me-central2 (KSA, Dammam)",
-		}
-	}
-	return map[string]string{
-		"europe-west3":         "europe-west3 (Europe, Frankfurt)",
-		"asia-south1":          "asia-south1 (India, Mumbai)",
-		"us-central1":          "us-central1 (US Central, IA)",
-		"me-central2":          "me-central2 (KSA, Dammam)",
-		"asia-northeast2":      "asia-northeast2 (Japan, Osaka)",
-		"me-west1":             "me-west1 (Israel, Tel Aviv)",
-		"southamerica-east1":   "southamerica-east1 (Brazil, São Paulo)",
-		"australia-southeast1": "australia-southeast1 (Australia, Sydney)",
-	}
+	return regions.DisplayNames(regions.GCP, false, assuredWorkloads)
 }
 
 func AWSRegions(euRestrictedAccess bool) []string {
-	// be aware of zones defined in internal/provider/aws_provider.go
-	if euRestrictedAccess {
-		return []string{"eu-central-1"}
-	}
-	return []string{"eu-central-1", "eu-west-2", "ca-central-1", "sa-east-1", "us-east-1", "us-west-1",
-		"ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"}
+	return regions.Names(regions.AWS, euRestrictedAccess, false)
 }
 
 func AWSRegionsDisplay() map[string]string {
-	return nil
+	return regions.DisplayNames(regions.AWS, false, false)
 }
 
 func SapConvergedCloudRegionsDisplay() map[string]string {
@@ -172,137 +144,43 @@ func SapConvergedCloudRegionsDisplay() map[string]string {
 }
 
 func AwsMachinesNames() []string {
-	return []string{
-		"m6i.large",
-		"m6i.xlarge",
-		"m6i.2xlarge",
-		"m6i.4xlarge",
-		"m6i.8xlarge",
-		"m6i.12xlarge",
-		"m5.large",
-		"m5.xlarge",
-		"m5.2xlarge",
-		"m5.4xlarge",
-		"m5.8xlarge",
-		"m5.12xlarge",
-	}
+	return regions.MachineTypes(regions.AWS)
 }
 
 func AwsMachinesDisplay() map[string]string {
-	return map[string]string{
-		"m6i.large":    "m6i.large (2vCPU, 8GB RAM)",
-		"m6i.xlarge":   "m6i.xlarge (4vCPU, 16GB RAM)",
-		"m6i.2xlarge":  "m6i.2xlarge (8vCPU, 32GB RAM)",
-		"m6i.4xlarge":  "m6i.4xlarge (16vCPU, 64GB RAM)",
-		"m6i.8xlarge":  "m6i.8xlarge (32vCPU, 128GB RAM)",
-		"m6i.12xlarge": "m6i.12xlarge (48vCPU, 192GB RAM)",
-		"m5.large":     "m5.large (2vCPU, 8GB RAM)",
-		"m5.xlarge":    "m5.xlarge (4vCPU, 16GB RAM)",
-		"m5.2xlarge":   "m5.2xlarge (8vCPU, 32GB RAM)",
-		"m5.4xlarge":   "m5.4xlarge (16vCPU, 64GB RAM)",
-		"m5.8xlarge":   "m5.8xlarge (32vCPU, 128GB RAM)",
-		"m5.12xlarge":  "m5.12xlarge (48vCPU, 192GB RAM)",
-	}
+	return regions.MachineTypesDisplay(regions.AWS)
 }
 
 func AzureMachinesNames() []string {
-	return []string{
-		"Standard_D2s_v5",
-		"Standard_D4s_v5",
-		"Standard_D8s_v5",
-		"Standard_D16s_v5",
-		"Standard_D32s_v5",
-		"Standard_D48s_v5",
-		"Standard_D64s_v5",
-		"Standard_D4_v3",
-		"Standard_D8_v3",
-		"Standard_D16_v3",
-		"Standard_D32_v3",
-		"Standard_D48_v3",
-		"Standard_D64_v3",
-	}
+	return regions.MachineTypes(regions.Azure)
 }
 
 func AzureMachinesDisplay() map[string]string {
-	return map[string]string{
-		"Standard_D2s_v5":  "Standard_D2s_v5 (2vCPU, 8GB RAM)",
-		"Standard_D4s_v5":  "Standard_D4s_v5 (4vCPU, 16GB RAM)",
-		"Standard_D8s_v5":  "Standard_D8s_v5 (8vCPU, 32GB RAM)",
-		"Standard_D16s_v5": "Standard_D16s_v5 (16vCPU, 64GB RAM)",
-		"Standard_D32s_v5": "Standard_D32s_v5 (32vCPU, 128GB RAM)",
-		"Standard_D48s_v5": "Standard_D48s_v5 (48vCPU, 192GB RAM)",
-		"Standard_D64s_v5": "Standard_D64s_v5 (64vCPU, 256GB RAM)",
-		"Standard_D4_v3":   "Standard_D4_v3 (4vCPU, 16GB RAM)",
-		"Standard_D8_v3":   "Standard_D8_v3 (8vCPU, 32GB RAM)",
-		"Standard_D16_v3":  "Standard_D16_v3 (16vCPU, 64GB RAM)",
-		"Standard_D32_v3":  "Standard_D32_v3 (32vCPU, 128GB RAM)",
-		"Standard_D48_v3":  "Standard_D48_v3 (48vCPU, 192GB RAM)",
-		"Standard_D64_v3":  "Standard_D64_v3 (64vCPU, 256GB RAM)",
-	}
+	return regions.MachineTypesDisplay(regions.Azure)
 }
 
 func AzureLiteMachinesNames() []string {
-	return []string{
-		"Standard_D2s_v5",
-		"Standard_D4s_v5",
-		"Standard_D4_v3",
-	}
+	return regions.MachineTypes(regions.AzureLite)
 }
 
 func AzureLiteMachinesDisplay() map[string]string {
-	return map[string]string{
-		"Standard_D2s_v5": "Standard_D2s_v5 (2vCPU, 8GB RAM)",
-		"Standard_D4s_v5": "Standard_D4s_v5 (4vCPU, 16GB RAM)",
-		"Standard_D4_v3":  "Standard_D4_v3 (4vCPU, 16GB RAM)",
-	}
+	return regions.MachineTypesDisplay(regions.AzureLite)
 }
 
 func GcpMachinesNames() []string {
-	return []string{
-		"n2-standard-2",
-		"n2-standard-4",
-		"n2-standard-8",
-		"n2-standard-16",
-		"n2-standard-32",
-		"n2-standard-48",
-	}
+	return regions.MachineTypes(regions.GCP)
 }
 
 func GcpMachinesDisplay() map[string]string {
-	return map[string]string{
-		"n2-standard-2":  "n2-standard-2 (2vCPU, 8GB RAM)",
-		"n2-standard-4":  "n2-standard-4 (4vCPU, 16GB RAM)",
-		"n2-standard-8":  "n2-standard-8 (8vCPU, 32GB RAM)",
-		"n2-standard-16": "n2-standard-16 (16vCPU, 64GB RAM)",
-		"n2-standard-32": "n2-standard-32 (32vCPU, 128GB RAM)",
-		"n2-standard-48": "n2-standard-48 (48vCPU, 192B RAM)",
-	}
+	return regions.MachineTypesDisplay(regions.GCP)
 }
 
 func SapConvergedCloudMachinesNames() []string {
-	return []string{
-		"g_c2_m8",
-		"g_c4_m16",
-		"g_c6_m24",
-		"g_c8_m32",
-		"g_c12_m48",
-		"g_c16_m64",
-		"g_c32_m128",
-		"g_c64_m256",
-	}
+	return regions.MachineTypes(regions.SapConvergedCloud)
 }
 
 func SapConvergedCloudMachinesDisplay() map[string]string {
-	return map[string]string{
-		"g_c2_m8":    "g_c2_m8 (2vCPU, 8GB RAM)",
-		"g_c4_m16":   "g_c4_m16 (4vCPU, 16GB RAM)",
-		"g_c6_m24":   "g_c6_m24 (6vCPU, 24GB RAM)",
-		"g_c8_m32":   "g_c8_m32 (8vCPU, 32GB RAM)",
-		"g_c12_m48":  "g_c12_m48 (12vCPU, 48GB RAM)",
-		"g_c16_m64":  "g_c16_m64 (16vCPU, 64GB RAM)",
-		"g_c32_m128": "g_c32_m128 (32vCPU, 128GB RAM)",
-		"g_c64_m256": "g_c64_m256 (64vCPU, 256GB RAM)",
-	}
+	return regions.MachineTypesDisplay(regions.SapConvergedCloud)
 }
 
 func removeMachinesNamesFromList(machinesNames []string, machinesNamesToRemove ...string) []string {
@@ -319,6 +197,9 @@ func removeMachinesNamesFromList(machinesNames []string, machinesNamesToRemove .
 	return machinesNames
 }
 
+// minUpdateVolumeSizeGb is the default volume size of the Kyma worker node pool in the plans supporting the volume size update
+const minUpdateVolumeSizeGb = 80
+
 func requiredSchemaProperties() []string {
 	return []string{"name", "region"}
 }
@@ -339,21 +220,33 @@ func SapConvergedCloudSchema(machineTypesDisplay, regionsDisplay map[string]stri
 func PreviewSchema(machineTypesDisplay, regionsDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, regionsDisplay, machineTypes, AWSRegions(euAccessRestricted), update)
 	properties.Networking = NewNetworkingSchema()
+	if update {
+		properties.IncludeVolumeSizeAndZones(minUpdateVolumeSizeGb)
+	}
 	return createSchemaWithProperties(properties, additionalParams, update, requiredSchemaProperties(), false, false)
 }
 
 func GCPSchema(machineTypesDisplay, regionsDisplay map[string]string, machineTypes []string, additionalParams, update bool, shootAndSeedFeatureFlag bool, assuredWorkloads bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, regionsDisplay, machineTypes, GcpRegions(assuredWorkloads), update)
+	if update {
+		properties.IncludeVolumeSizeAndZones(minUpdateVolumeSizeGb)
+	}
 	return createSchemaWithProperties(properties, additionalParams, update, requiredSchemaProperties(), true, shootAndSeedFeatureFlag)
 }
 
 func AWSSchema(machineTypesDisplay, regionsDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool, shootAndSeedSameRegion bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, regionsDisplay, machineTypes, AWSRegions(euAccessRestricted), update)
+	if update {
+		properties.IncludeVolumeSizeAndZones(minUpdateVolumeSizeGb)
+	}
 	return createSchemaWithProperties(properties, additionalParams, update, requiredSchemaProperties(), true, shootAndSeedSameRegion)
 }
 
 func AzureSchema(machineTypesDisplay, regionsDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool, shootAndSeedFeatureFlag bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, regionsDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
+	if update {
+		properties.IncludeVolumeSizeAndZones(minUpdateVolumeSizeGb)
+	}
 	return createSchemaWithProperties(properties, additionalParams, update, requiredSchemaProperties(), true, shootAndSeedFeatureFlag)
 }
 
@@ -528,6 +421,15 @@ func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditional
 		outputPlans[SapConvergedCloudPlanID] = defaultServicePlan(SapConvergedCloudPlanID, SapConvergedCloudPlanName, plans, sapConvergedCloudSchema, SapConvergedCloudSchema(sapConvergedCloudMachinesDisplay, sapConvergedCloudRegionsDisplay, sapConvergedCloudMachinesNames, includeAdditionalParamsInSchema, true, shootAndSeedFeatureFlag, sapConvergedCloudRegions))
 	}
 
+	// plans loaded from the plans definitions file are offered only when their base plan is offered
+	for _, def := range sortedPlanDefinitions() {
+		base, found := outputPlans[builtInPlanIDs[def.BasePlan]]
+		if !found {
+			continue
+		}
+		outputPlans[def.ID] = definedServicePlan(def, plans, base, includeAdditionalParamsInSchema, euAccessRestricted, useSmallerMachineTypes, shootAndSeedFeatureFlag, sapConvergedCloudRegions, assuredWorkloads)
+	}
+
 	return outputPlans
 }
 
@@ -574,7 +476,7 @@ func defaultMetadata(planName string, plans PlansConfig) *domain.ServicePlanMeta
 }
 
 func IsTrialPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case TrialPlanID:
 		return true
 	default:
@@ -583,7 +485,7 @@ func IsTrialPlan(planID string) bool {
 }
 
 func IsSapConvergedCloudPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case SapConvergedCloudPlanID:
 		return true
 	default:
@@ -592,7 +494,7 @@ func IsSapConvergedCloudPlan(planID string) bool {
 }
 
 func IsPreviewPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case PreviewPlanID:
 		return true
 	default:
@@ -601,7 +503,7 @@ func IsPreviewPlan(planID string) bool {
 }
 
 func IsAzurePlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case AzurePlanID, AzureLitePlanID:
 		return true
 	default:
@@ -610,7 +512,7 @@ func IsAzurePlan(planID string) bool {
 }
 
 func IsFreemiumPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case FreemiumPlanID:
 		return true
 	default:
@@ -618,8 +520,18 @@ func IsFreemiumPlan(planID string) bool {
 	}
 }
 
+// IsVolumeSizeAndZonesUpdateSupported returns true if the volume size and zones of the Kyma worker node pool can be changed in the update request
+func IsVolumeSizeAndZonesUpdateSupported(planID string) bool {
+	switch BasePlanID(planID) {
+	case AWSPlanID, AzurePlanID, GCPPlanID, PreviewPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsOwnClusterPlan(planID string) bool {
-	return planID == OwnClusterPlanID
+	return BasePlanID(planID) == OwnClusterPlanID
 }
 
 func filter(items *[]interface{}, included map[string]interface{}) interface{} {
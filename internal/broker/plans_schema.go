@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/networking"
 )
 
@@ -28,7 +29,6 @@ type ProvisioningProperties struct {
 	ShootDomain            *Type           `json:"shootDomain,omitempty"`
 	Region                 *Type           `json:"region,omitempty"`
 	Networking             *NetworkingType `json:"networking,omitempty"`
-	Modules                *Modules        `json:"modules,omitempty"`
 	ShootAndSeedSameRegion *Type           `json:"shootAndSeedSameRegion,omitempty"`
 }
 
@@ -39,11 +39,75 @@ type UpdateProperties struct {
 	OIDC           *OIDCType `json:"oidc,omitempty"`
 	Administrators *Type     `json:"administrators,omitempty"`
 	MachineType    *Type     `json:"machineType,omitempty"`
+	Modules        *Modules  `json:"modules,omitempty"`
+
+	AdditionalWorkerNodePools *AdditionalWorkerNodePoolsType `json:"additionalWorkerNodePools,omitempty"`
+	MaintenanceWindow         *MaintenanceWindowType         `json:"maintenanceWindow,omitempty"`
+
+	VolumeSizeGb *Type `json:"volumeSizeGb,omitempty"`
+	MultiZone    *Type `json:"multiZone,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
 	up.OIDC = NewOIDCSchema()
 	up.Administrators = AdministratorsProperty()
+	up.MaintenanceWindow = NewMaintenanceWindowSchema()
+	if up.MachineType != nil {
+		up.AdditionalWorkerNodePools = NewAdditionalWorkerNodePoolsSchema(up.MachineType.EnumDisplayName, up.MachineType.Enum)
+	}
+}
+
+// IncludeVolumeSizeAndZones adds the update-only properties changing the volume size and the zones of the Kyma worker node pool.
+// The volume size cannot be lower than the default one, lower values of the given instance are rejected by the update endpoint.
+func (up *UpdateProperties) IncludeVolumeSizeAndZones(minVolumeSizeGb int) {
+	up.VolumeSizeGb = &Type{
+		Type:        "integer",
+		Minimum:     minVolumeSizeGb,
+		Description: "Specifies the size of the root volume in GB. The volume size can only be increased.",
+	}
+	up.MultiZone = &Type{
+		Type:        "boolean",
+		Description: "Spreads the Kyma worker node pool across multiple zones. Multiple zones can only be enabled, they cannot be removed later.",
+	}
+}
+
+type AdditionalWorkerNodePoolsType struct {
+	Type
+	Items AdditionalWorkerNodePoolsItems `json:"items"`
+}
+
+type AdditionalWorkerNodePoolsItems struct {
+	Type
+	ControlsOrder []string                                 `json:"_controlsOrder"`
+	Properties    AdditionalWorkerNodePoolsItemsProperties `json:"properties"`
+	Required      []string                                 `json:"required"`
+}
+
+type AdditionalWorkerNodePoolsItemsProperties struct {
+	Name          Type              `json:"name"`
+	MachineType   Type              `json:"machineType"`
+	HAZones       Type              `json:"haZones"`
+	AutoScalerMin AutoScalerMinType `json:"autoScalerMin"`
+	AutoScalerMax Type              `json:"autoScalerMax"`
+}
+
+// AutoScalerMinType always renders the minimum, because zero is a valid lower bound for additional worker node pools
+type AutoScalerMinType struct {
+	Type
+	Minimum int `json:"minimum"`
+}
+
+type MaintenanceWindowType struct {
+	Type
+	ControlsOrder []string                    `json:"_controlsOrder"`
+	Properties    MaintenanceWindowProperties `json:"properties"`
+	Required      []string                    `json:"required"`
+}
+
+type MaintenanceWindowProperties struct {
+	Days      Type `json:"days"`
+	TimeBegin Type `json:"timeBegin"`
+	TimeEnd   Type `json:"timeEnd"`
 }
 
 type NetworkingProperties struct {
@@ -61,18 +125,40 @@ type NetworkingType struct {
 type OIDCProperties struct {
 	ClientID       Type `json:"clientID"`
 	GroupsClaim    Type `json:"groupsClaim"`
+	GroupsPrefix   Type `json:"groupsPrefix"`
 	IssuerURL      Type `json:"issuerURL"`
 	SigningAlgs    Type `json:"signingAlgs"`
 	UsernameClaim  Type `json:"usernameClaim"`
 	UsernamePrefix Type `json:"usernamePrefix"`
+	RequiredClaims Type `json:"requiredClaims"`
 }
 
 type OIDCType struct {
+	Type
+	OneOf []interface{} `json:"oneOf,omitempty"`
+}
+
+type OIDCIssuerType struct {
 	Type
 	Properties OIDCProperties `json:"properties"`
 	Required   []string       `json:"required"`
 }
 
+type OIDCListType struct {
+	Type
+	Properties OIDCListProperties `json:"properties"`
+	Required   []string           `json:"required"`
+}
+
+type OIDCListProperties struct {
+	List OIDCIssuersType `json:"list"`
+}
+
+type OIDCIssuersType struct {
+	Type
+	Items OIDCIssuerType `json:"items"`
+}
+
 type Type struct {
 	Type        string `json:"type"`
 	Title       string `json:"title,omitempty"`
@@ -81,6 +167,7 @@ type Type struct {
 	Maximum     int    `json:"maximum,omitempty"`
 	MinLength   int    `json:"minLength,omitempty"`
 	MaxLength   int    `json:"maxLength,omitempty"`
+	MinItems    int    `json:"minItems,omitempty"`
 
 	// Regex pattern to match against string type of fields.
 	// If not specified for strings user can pass empty string with whitespaces only.
@@ -299,6 +386,7 @@ func NewProvisioningProperties(machineTypesDisplay, regionsDisplay map[string]st
 				Enum:            ToInterfaceSlice(machineTypes),
 				EnumDisplayName: machineTypesDisplay,
 			},
+			Modules: NewModulesSchema(),
 		},
 		Name: NameProperty(),
 		Region: &Type{
@@ -308,7 +396,6 @@ func NewProvisioningProperties(machineTypesDisplay, regionsDisplay map[string]st
 			MinLength:       1,
 		},
 		Networking: NewNetworkingSchema(),
-		Modules:    NewModulesSchema(),
 	}
 
 	if update {
@@ -337,22 +424,136 @@ func NewNetworkingSchema() *NetworkingType {
 
 func NewOIDCSchema() *OIDCType {
 	return &OIDCType{
-		Type: Type{Type: "object", Description: "OIDC configuration"},
-		Properties: OIDCProperties{
-			ClientID:       Type{Type: "string", Description: "The client ID for the OpenID Connect client."},
-			IssuerURL:      Type{Type: "string", Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted."},
-			GroupsClaim:    Type{Type: "string", Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups."},
-			UsernameClaim:  Type{Type: "string", Description: "The OpenID claim to use as the user name."},
-			UsernamePrefix: Type{Type: "string", Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-' (dash character without additional characters)."},
-			SigningAlgs: Type{
-				Type: "array",
+		Type: Type{Type: "object", Description: "OIDC configuration. Provide a single OIDC issuer or a list of OIDC issuers, the first issuer on the list is the primary one."},
+		OneOf: []interface{}{
+			OIDCIssuerType{
+				Type:       Type{Type: "object", Title: "Single issuer", Description: "OIDC configuration of a single issuer"},
+				Properties: newOIDCIssuerProperties(),
+				Required:   []string{"clientID", "issuerURL"},
+			},
+			OIDCListType{
+				Type: Type{Type: "object", Title: "List of issuers", Description: "OIDC configuration of multiple issuers", AdditionalProperties: false},
+				Properties: OIDCListProperties{
+					List: OIDCIssuersType{
+						Type: Type{Type: "array", MinItems: 1, Description: "The list of OIDC issuers. A kubeconfig context is generated for every issuer."},
+						Items: OIDCIssuerType{
+							Type:       Type{Type: "object"},
+							Properties: newOIDCIssuerProperties(),
+							Required:   []string{"clientID", "issuerURL"},
+						},
+					},
+				},
+				Required: []string{"list"},
+			},
+		},
+	}
+}
+
+func NewMaintenanceWindowSchema() *MaintenanceWindowType {
+	timePattern := "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	return &MaintenanceWindowType{
+		Type:          Type{Type: "object", Description: "Maintenance window of the runtime. Orchestrated upgrades are scheduled within this window."},
+		ControlsOrder: []string{"days", "timeBegin", "timeEnd"},
+		Properties: MaintenanceWindowProperties{
+			Days: Type{
+				Type:        "array",
+				Title:       "Days",
+				Description: "Days of the week when the maintenance is allowed. If not provided, every day is allowed.",
+				UniqueItems: true,
 				Items: &Type{
 					Type: "string",
+					Enum: ToInterfaceSlice([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}),
+				},
+			},
+			TimeBegin: Type{Type: "string", Title: "Begin time", Description: "Begin time of the maintenance window in UTC, in the HH:MM format", Pattern: timePattern},
+			TimeEnd:   Type{Type: "string", Title: "End time", Description: "End time of the maintenance window in UTC, in the HH:MM format", Pattern: timePattern},
+		},
+		Required: []string{"timeBegin", "timeEnd"},
+	}
+}
+
+func newOIDCIssuerProperties() OIDCProperties {
+	return OIDCProperties{
+		ClientID:       Type{Type: "string", Description: "The client ID for the OpenID Connect client."},
+		IssuerURL:      Type{Type: "string", Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted."},
+		GroupsClaim:    Type{Type: "string", Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups."},
+		GroupsPrefix:   Type{Type: "string", Description: "If provided, all groups are prefixed with this value to prevent conflicts with other authentication strategies."},
+		UsernameClaim:  Type{Type: "string", Description: "The OpenID claim to use as the user name."},
+		UsernamePrefix: Type{Type: "string", Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-' (dash character without additional characters)."},
+		SigningAlgs: Type{
+			Type: "array",
+			Items: &Type{
+				Type: "string",
+			},
+			Description: "Comma separated list of allowed JOSE asymmetric signing algorithms, for example, RS256, ES256",
+		},
+		RequiredClaims: Type{
+			Type: "array",
+			Items: &Type{
+				Type:    "string",
+				Pattern: "^[^=]+=.+$",
+			},
+			Description: "List of claims in the claim=value format, which must be present in the ID token with the given value.",
+		},
+	}
+}
+
+func NewAdditionalWorkerNodePoolsSchema(machineTypesDisplay map[string]string, machineTypes []interface{}) *AdditionalWorkerNodePoolsType {
+	return &AdditionalWorkerNodePoolsType{
+		Type: Type{
+			Type:        "array",
+			UniqueItems: true,
+			Description: "Specifies the list of additional worker node pools. The provided list replaces all existing additional worker node pools, an empty list removes them.",
+		},
+		Items: AdditionalWorkerNodePoolsItems{
+			Type: Type{
+				Type:                 "object",
+				AdditionalProperties: false,
+			},
+			ControlsOrder: []string{"name", "machineType", "haZones", "autoScalerMin", "autoScalerMax"},
+			Properties: AdditionalWorkerNodePoolsItemsProperties{
+				Name: Type{
+					Type:        "string",
+					Title:       "Name",
+					Description: "Specifies the unique name of the additional worker node pool. The name must consist of lowercase alphanumeric characters or '-', must start and end with an alphanumeric character, and can be a maximum of 15 characters in length.",
+					Pattern:     internal.AdditionalWorkerNodePoolNamePattern,
+					MinLength:   1,
+					MaxLength:   internal.AdditionalWorkerNodePoolNameMaxLength,
+				},
+				MachineType: Type{
+					Type:            "string",
+					Title:           "Machine Type",
+					Description:     "Specifies the type of the virtual machine.",
+					Enum:            machineTypes,
+					EnumDisplayName: machineTypesDisplay,
+				},
+				HAZones: Type{
+					Type:        "boolean",
+					Title:       "HA zones",
+					Description: "Specifies whether the nodes are spread across all availability zones of the cluster. Cannot be changed for an existing pool.",
+					Default:     true,
+				},
+				AutoScalerMin: AutoScalerMinType{
+					Type: Type{
+						Type:        "integer",
+						Title:       "Autoscaler Min",
+						Description: "Specifies the minimum number of virtual machines to create, at least 3 when HA zones are enabled",
+						Maximum:     300,
+						Default:     3,
+					},
+					Minimum: 0,
+				},
+				AutoScalerMax: Type{
+					Type:        "integer",
+					Title:       "Autoscaler Max",
+					Description: "Specifies the maximum number of virtual machines to create",
+					Minimum:     1,
+					Maximum:     300,
+					Default:     20,
 				},
-				Description: "Comma separated list of allowed JOSE asymmetric signing algorithms, for example, RS256, ES256",
 			},
+			Required: []string{"name", "machineType", "haZones", "autoScalerMin", "autoScalerMax"},
 		},
-		Required: []string{"clientID", "issuerURL"},
 	}
 }
 
@@ -386,7 +587,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "shootAndSeedSameRegion", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "modules", "networking", "oidc", "administrators"}
+	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "shootAndSeedSameRegion", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "volumeSizeGb", "multiZone", "additionalWorkerNodePools", "modules", "networking", "oidc", "administrators", "maintenanceWindow"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
@@ -0,0 +1,78 @@
+package broker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+)
+
+// RuntimeVersionsConfig configures the kubernetesVersion and machineImage provisioning parameters.
+// The parameters are accepted only for global accounts listed in AllowedGlobalAccounts and only with values allowed for the plan.
+type RuntimeVersionsConfig struct {
+	// KubernetesVersions in the "plan:version" format separated by commas, for example "aws:1.29,aws:1.30"
+	KubernetesVersions PlanAllowList `envconfig:"optional"`
+	// MachineImages in the "plan:name:version" format separated by commas, for example "aws:gardenlinux:1443.3.0"
+	MachineImages         PlanAllowList `envconfig:"optional"`
+	AllowedGlobalAccounts []string      `envconfig:"optional"`
+}
+
+// PlanAllowList lists values allowed for a plan, the key is the name of the plan
+type PlanAllowList map[string][]string
+
+// Unmarshal provides custom parsing of the allow list in the "plan:value" format separated by commas.
+// Implements envconfig.Unmarshal interface.
+func (l *PlanAllowList) Unmarshal(in string) error {
+	allowList := PlanAllowList{}
+	for _, pair := range strings.Split(in, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		plan, value, found := strings.Cut(pair, ":")
+		if !found || plan == "" || value == "" {
+			return fmt.Errorf("invalid allow list entry %q, expected format plan:value", pair)
+		}
+		allowList[plan] = append(allowList[plan], value)
+	}
+	*l = allowList
+	return nil
+}
+
+// Allowed returns true if the value is allowed for the plan
+func (l PlanAllowList) Allowed(planID, value string) bool {
+	return slices.Contains(l[PlanNameForID(planID)], value)
+}
+
+// Validate checks if all plans are known
+func (c RuntimeVersionsConfig) Validate() error {
+	for _, allowList := range []PlanAllowList{c.KubernetesVersions, c.MachineImages} {
+		for plan := range allowList {
+			if _, exists := PlanIDForName(plan); !exists {
+				return fmt.Errorf("unrecognized %v plan name in runtime versions", plan)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateParameters checks if the requested Kubernetes version and machine image can be used for the plan and the global account
+func (c RuntimeVersionsConfig) ValidateParameters(planID, globalAccountID string, parameters internal.ProvisioningParametersDTO) error {
+	if parameters.KubernetesVersion == nil && parameters.MachineImage == nil {
+		return nil
+	}
+	if !slices.Contains(c.AllowedGlobalAccounts, globalAccountID) {
+		return fmt.Errorf("the kubernetesVersion and machineImage parameters are not allowed for the global account")
+	}
+	if parameters.KubernetesVersion != nil && !c.KubernetesVersions.Allowed(planID, *parameters.KubernetesVersion) {
+		return fmt.Errorf("kubernetes version %s is not supported for the %s plan", *parameters.KubernetesVersion, PlanNameForID(planID))
+	}
+	if parameters.MachineImage != nil {
+		image := fmt.Sprintf("%s:%s", parameters.MachineImage.Name, parameters.MachineImage.Version)
+		if !c.MachineImages.Allowed(planID, image) {
+			return fmt.Errorf("machine image %s is not supported for the %s plan", image, PlanNameForID(planID))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeVersionsConfig(t *testing.T) {
+	t.Run("should parse allow lists", func(t *testing.T) {
+		// given
+		var versions, images PlanAllowList
+
+		// when
+		err := versions.Unmarshal("aws:1.29, aws:1.30,azure:1.30")
+		require.NoError(t, err)
+		err = images.Unmarshal("aws:gardenlinux:1443.3.0")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, PlanAllowList{"aws": {"1.29", "1.30"}, "azure": {"1.30"}}, versions)
+		assert.Equal(t, PlanAllowList{"aws": {"gardenlinux:1443.3.0"}}, images)
+		assert.NoError(t, RuntimeVersionsConfig{KubernetesVersions: versions, MachineImages: images}.Validate())
+		assert.True(t, versions.Allowed(AWSPlanID, "1.29"))
+		assert.False(t, versions.Allowed(AzurePlanID, "1.29"))
+	})
+
+	t.Run("should reject invalid format and unknown plans", func(t *testing.T) {
+		var versions PlanAllowList
+		assert.Error(t, versions.Unmarshal("aws"))
+		assert.Error(t, versions.Unmarshal("aws:"))
+		assert.Error(t, RuntimeVersionsConfig{KubernetesVersions: PlanAllowList{"unknown": {"1.30"}}}.Validate())
+	})
+
+	t.Run("should validate parameters", func(t *testing.T) {
+		// given
+		cfg := RuntimeVersionsConfig{
+			KubernetesVersions:    PlanAllowList{"aws": {"1.29"}},
+			MachineImages:         PlanAllowList{"aws": {"gardenlinux:1443.3.0"}},
+			AllowedGlobalAccounts: []string{"allowed-ga"},
+		}
+		image := &internal.MachineImageDTO{Name: "gardenlinux", Version: "1443.3.0"}
+
+		// then
+		assert.NoError(t, cfg.ValidateParameters(AWSPlanID, "other-ga", internal.ProvisioningParametersDTO{}))
+		assert.NoError(t, cfg.ValidateParameters(AWSPlanID, "allowed-ga", internal.ProvisioningParametersDTO{KubernetesVersion: ptr.String("1.29"), MachineImage: image}))
+		assert.Error(t, cfg.ValidateParameters(AWSPlanID, "other-ga", internal.ProvisioningParametersDTO{KubernetesVersion: ptr.String("1.29")}))
+		assert.Error(t, cfg.ValidateParameters(AWSPlanID, "allowed-ga", internal.ProvisioningParametersDTO{KubernetesVersion: ptr.String("1.30")}))
+		assert.Error(t, cfg.ValidateParameters(AzurePlanID, "allowed-ga", internal.ProvisioningParametersDTO{KubernetesVersion: ptr.String("1.29")}))
+		assert.Error(t, cfg.ValidateParameters(AWSPlanID, "allowed-ga", internal.ProvisioningParametersDTO{MachineImage: &internal.MachineImageDTO{Name: "gardenlinux", Version: "1312.3.0"}}))
+	})
+}
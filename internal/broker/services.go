@@ -3,6 +3,7 @@ package broker
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/assuredworkloads"
 
@@ -23,6 +24,7 @@ type ServicesEndpoint struct {
 	log            logrus.FieldLogger
 	cfg            Config
 	servicesConfig ServicesConfig
+	mu             sync.RWMutex
 
 	enabledPlanIDs                map[string]struct{}
 	convergedCloudRegionsProvider ConvergedCloudRegionProvider
@@ -31,7 +33,7 @@ type ServicesEndpoint struct {
 func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogger, convergedCloudRegionsProvider ConvergedCloudRegionProvider) *ServicesEndpoint {
 	enabledPlanIDs := map[string]struct{}{}
 	for _, planName := range cfg.EnablePlans {
-		id := PlanIDsMapping[planName]
+		id, _ := PlanIDForName(planName)
 		enabledPlanIDs[id] = struct{}{}
 	}
 
@@ -44,6 +46,13 @@ func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogg
 	}
 }
 
+// SetServicesConfig replaces the services configuration read from the catalog file
+func (b *ServicesEndpoint) SetServicesConfig(servicesConfig ServicesConfig) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.servicesConfig = servicesConfig
+}
+
 // Services gets the catalog of services offered by the service broker
 //
 //	GET /v2/catalog
@@ -51,7 +60,9 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 	var availableServicePlans []domain.ServicePlan
 	bindable := true
 	// we scope to the kymaruntime service only
+	b.mu.RLock()
 	class, ok := b.servicesConfig[KymaServiceName]
+	b.mu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("while getting %s class data", KymaServiceName)
 	}
@@ -75,6 +86,9 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 		if b.cfg.Binding.Enabled && b.cfg.Binding.BindablePlans.Contains(plan.Name) {
 			plan.Bindable = &bindable
 		}
+		if b.cfg.PlanUpgrades.Updatable(plan.ID) {
+			plan.PlanUpdatable = domain.PlanUpdatableValue(true)
+		}
 
 		availableServicePlans = append(availableServicePlans, plan)
 	}
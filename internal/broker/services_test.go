@@ -148,6 +148,32 @@ func TestServices_Services(t *testing.T) {
 		assertBindableForPlan(t, services, "gcp")
 		assertNotBindableForPlan(t, services, "azure")
 	})
+
+	t.Run("should contain 'plan_updateable' for plans with configured upgrades", func(t *testing.T) {
+		// given
+		cfg := broker.Config{
+			EnablePlans:  []string{"azure", "azure_lite", "aws"},
+			PlanUpgrades: broker.PlanUpgrades{"azure_lite": {"azure"}},
+		}
+		servicesConfig := map[string]broker.Service{
+			broker.KymaServiceName: {},
+		}
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), &broker.OneForAllConvergedCloudRegionsProvider{})
+
+		// when
+		services, err := servicesEndpoint.Services(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		for _, plan := range services[0].Plans {
+			if plan.Name == "azure_lite" {
+				require.NotNil(t, plan.PlanUpdatable)
+				assert.True(t, *plan.PlanUpdatable)
+			} else {
+				assert.Nil(t, plan.PlanUpdatable, plan.Name)
+			}
+		}
+	})
 }
 
 func assertBindableForPlan(t *testing.T, services []domain.Service, planName string) {
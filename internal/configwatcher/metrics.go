@@ -0,0 +1,32 @@
+package configwatcher
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	metricsNamespace = "kcp"
+	metricsSubsystem = "keb_config"
+)
+
+type Metrics struct {
+	reloads  *prometheus.CounterVec
+	failures *prometheus.CounterVec
+}
+
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		reloads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "reloads_total",
+			Help:      "Number of configuration files reloaded after a change.",
+		}, []string{"file"}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "reload_failures_total",
+			Help:      "Number of changed configuration files rejected because they could not be read or were invalid.",
+		}, []string{"file"}),
+	}
+	reg.MustRegister(m.reloads, m.failures)
+	return m
+}
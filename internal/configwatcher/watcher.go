@@ -0,0 +1,93 @@
+package configwatcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ReloadFunc reads and validates the file and, if the file is valid, replaces the configuration in use.
+// It must not change the configuration in use when it returns an error.
+type ReloadFunc func(path string) error
+
+// Watcher checks configuration files periodically and reloads the files which content has changed.
+// A file which cannot be reloaded is logged and counted, the previous configuration stays in use.
+type Watcher struct {
+	interval time.Duration
+	files    []*watchedFile
+	metrics  *Metrics
+	log      logrus.FieldLogger
+}
+
+type watchedFile struct {
+	name    string
+	path    string
+	reload  ReloadFunc
+	content []byte
+}
+
+func New(interval time.Duration, metrics *Metrics, log logrus.FieldLogger) *Watcher {
+	return &Watcher{
+		interval: interval,
+		metrics:  metrics,
+		log:      log.WithField("service", "ConfigWatcher"),
+	}
+}
+
+// Add registers the file, the current content of the file is treated as already loaded.
+// Files must be added before the watcher is started.
+func (w *Watcher) Add(name, path string, reload ReloadFunc) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		w.log.Warnf("unable to read %s file %s: %s", name, path, err)
+	}
+	w.files = append(w.files, &watchedFile{name: name, path: path, reload: reload, content: content})
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	w.log.Infof("watching %d configuration files every %s", len(w.files), w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.checkFiles()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *Watcher) checkFiles() {
+	for _, file := range w.files {
+		err := w.checkFile(file)
+		if err != nil {
+			w.log.Errorf("%s file %s rejected, the previous configuration stays in use: %s", file.name, file.path, err)
+			w.metrics.failures.WithLabelValues(file.name).Inc()
+		}
+	}
+}
+
+func (w *Watcher) checkFile(file *watchedFile) error {
+	content, err := os.ReadFile(file.path)
+	if err != nil {
+		return fmt.Errorf("while reading file: %w", err)
+	}
+	if bytes.Equal(content, file.content) {
+		return nil
+	}
+	// the file is not checked again until it changes
+	file.content = content
+
+	if err := file.reload(file.path); err != nil {
+		return err
+	}
+	w.log.Infof("%s file %s reloaded", file.name, file.path)
+	w.metrics.reloads.WithLabelValues(file.name).Inc()
+	return nil
+}
@@ -0,0 +1,92 @@
+package configwatcher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("initial"), 0644))
+
+	var mu sync.Mutex
+	current := "initial"
+	reload := func(path string) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if string(content) == "invalid" {
+			return fmt.Errorf("invalid content")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		current = string(content)
+		return nil
+	}
+	loaded := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return current
+	}
+
+	metrics := NewMetrics(prometheus.NewRegistry())
+	watcher := New(10*time.Millisecond, metrics, logrus.New())
+	watcher.Add("config", path, reload)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.Run(ctx)
+
+	t.Run("should reload changed file", func(t *testing.T) {
+		// when
+		writeFile(t, path, "changed")
+
+		// then
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(metrics.reloads.WithLabelValues("config")) == 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "changed", loaded())
+	})
+
+	t.Run("should keep previous configuration when file is invalid", func(t *testing.T) {
+		// when
+		writeFile(t, path, "invalid")
+
+		// then
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(metrics.failures.WithLabelValues("config")) == 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "changed", loaded())
+	})
+
+	t.Run("should report missing file", func(t *testing.T) {
+		// when
+		require.NoError(t, os.Remove(path))
+
+		// then
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(metrics.failures.WithLabelValues("config")) > 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "changed", loaded())
+	})
+}
+
+// writeFile replaces the file atomically, the same way as the kubelet updates mounted config maps
+func writeFile(t *testing.T, path, content string) {
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte(content), 0644))
+	require.NoError(t, os.Rename(tmp, path))
+}
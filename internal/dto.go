@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
 )
@@ -17,23 +20,72 @@ const (
 type OIDCConfigDTO struct {
 	ClientID       string   `json:"clientID" yaml:"clientID"`
 	GroupsClaim    string   `json:"groupsClaim" yaml:"groupsClaim"`
+	GroupsPrefix   string   `json:"groupsPrefix,omitempty" yaml:"groupsPrefix,omitempty"`
 	IssuerURL      string   `json:"issuerURL" yaml:"issuerURL"`
 	SigningAlgs    []string `json:"signingAlgs" yaml:"signingAlgs"`
 	UsernameClaim  string   `json:"usernameClaim" yaml:"usernameClaim"`
 	UsernamePrefix string   `json:"usernamePrefix" yaml:"usernamePrefix"`
+	// RequiredClaims contains claims in the "claim=value" format, which must be present in the ID token with the given value
+	RequiredClaims []string `json:"requiredClaims,omitempty" yaml:"requiredClaims,omitempty"`
+
+	// List contains configurations of multiple OIDC issuers, the first one is the primary issuer.
+	// The list cannot be used along with the fields of a single issuer.
+	List []OIDCConfigDTO `json:"list,omitempty" yaml:"-"`
 }
 
 func (o *OIDCConfigDTO) IsProvided() bool {
 	if o == nil {
 		return false
 	}
-	if o.ClientID == "" && o.IssuerURL == "" && o.GroupsClaim == "" && o.UsernamePrefix == "" && o.UsernameClaim == "" && len(o.SigningAlgs) == 0 {
+	if len(o.List) > 0 {
+		return true
+	}
+	return o.isIssuerProvided()
+}
+
+func (o *OIDCConfigDTO) isIssuerProvided() bool {
+	if o.ClientID == "" && o.IssuerURL == "" && o.GroupsClaim == "" && o.GroupsPrefix == "" && o.UsernamePrefix == "" && o.UsernameClaim == "" && len(o.SigningAlgs) == 0 && len(o.RequiredClaims) == 0 {
 		return false
 	}
 	return true
 }
 
+// Issuers returns configurations of all provided OIDC issuers, the first one is the primary issuer
+func (o *OIDCConfigDTO) Issuers() []OIDCConfigDTO {
+	if !o.IsProvided() {
+		return nil
+	}
+	if len(o.List) > 0 {
+		return o.List
+	}
+	return []OIDCConfigDTO{*o}
+}
+
 func (o *OIDCConfigDTO) Validate() error {
+	if len(o.List) == 0 {
+		return o.validateIssuer()
+	}
+	if o.isIssuerProvided() {
+		return fmt.Errorf("list of OIDC issuers cannot be provided along with a single OIDC issuer")
+	}
+	issuers := make(map[string]struct{}, len(o.List))
+	for i, issuer := range o.List {
+		if len(issuer.List) > 0 {
+			return fmt.Errorf("OIDC issuer %d: nested list of OIDC issuers is not allowed", i+1)
+		}
+		if err := issuer.validateIssuer(); err != nil {
+			return fmt.Errorf("OIDC issuer %d: %w", i+1, err)
+		}
+		key := issuer.IssuerURL + " " + issuer.ClientID
+		if _, exists := issuers[key]; exists {
+			return fmt.Errorf("OIDC issuer %d: duplicated issuerURL %s with clientID %s", i+1, issuer.IssuerURL, issuer.ClientID)
+		}
+		issuers[key] = struct{}{}
+	}
+	return nil
+}
+
+func (o *OIDCConfigDTO) validateIssuer() error {
 	errs := make([]string, 0)
 	if len(o.ClientID) == 0 {
 		errs = append(errs, "clientID must not be empty")
@@ -58,6 +110,9 @@ func (o *OIDCConfigDTO) Validate() error {
 			}
 		}
 	}
+	if _, err := o.RequiredClaimsMap(); err != nil {
+		errs = append(errs, err.Error())
+	}
 
 	if len(errs) > 0 {
 		err := fmt.Errorf(strings.Join(errs, ", "))
@@ -66,6 +121,22 @@ func (o *OIDCConfigDTO) Validate() error {
 	return nil
 }
 
+// RequiredClaimsMap converts the required claims from the "claim=value" format
+func (o *OIDCConfigDTO) RequiredClaimsMap() (map[string]string, error) {
+	if len(o.RequiredClaims) == 0 {
+		return nil, nil
+	}
+	claims := make(map[string]string, len(o.RequiredClaims))
+	for _, requiredClaim := range o.RequiredClaims {
+		claim, value, found := strings.Cut(requiredClaim, "=")
+		if !found || claim == "" || value == "" {
+			return nil, fmt.Errorf("requiredClaims must be in the claim=value format")
+		}
+		claims[claim] = value
+	}
+	return claims, nil
+}
+
 func (o *OIDCConfigDTO) validSigningAlgsSet() map[string]bool {
 	algs := strings.Split(oidcValidSigningAlgs, ",")
 	signingAlgsSet := make(map[string]bool, len(algs))
@@ -158,6 +229,110 @@ func (p AutoScalerParameters) Validate(planMin, planMax int) error {
 	return nil
 }
 
+// KymaWorkerPoolName is the name of the worker pool created for every runtime, additional worker node pools must not use it
+const KymaWorkerPoolName = "cpu-worker-0"
+
+// AdditionalWorkerNodePoolNamePattern and AdditionalWorkerNodePoolNameMaxLength restrict the names of additional worker node pools to the names accepted by Gardener
+const (
+	AdditionalWorkerNodePoolNamePattern   = "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	AdditionalWorkerNodePoolNameMaxLength = 15
+)
+
+var additionalWorkerNodePoolNameRegexp = regexp.MustCompile(AdditionalWorkerNodePoolNamePattern)
+
+type AdditionalWorkerNodePool struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	HAZones       bool   `json:"haZones"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
+func (p AdditionalWorkerNodePool) Validate() error {
+	if !additionalWorkerNodePoolNameRegexp.MatchString(p.Name) {
+		return fmt.Errorf("additional worker node pool name %q must consist of lowercase alphanumeric characters or '-', and must start and end with an alphanumeric character", p.Name)
+	}
+	if len(p.Name) > AdditionalWorkerNodePoolNameMaxLength {
+		return fmt.Errorf("additional worker node pool name %s must not be longer than %d characters", p.Name, AdditionalWorkerNodePoolNameMaxLength)
+	}
+	if p.Name == KymaWorkerPoolName {
+		return fmt.Errorf("additional worker node pool name %s is reserved", p.Name)
+	}
+	if p.AutoScalerMin < 0 {
+		return fmt.Errorf("additional worker node pool %s: autoScalerMin %d must not be negative", p.Name, p.AutoScalerMin)
+	}
+	if p.AutoScalerMin > p.AutoScalerMax {
+		return fmt.Errorf("additional worker node pool %s: autoScalerMax %d should be larger than autoScalerMin %d", p.Name, p.AutoScalerMax, p.AutoScalerMin)
+	}
+	if p.HAZones && p.AutoScalerMin < 3 {
+		return fmt.Errorf("additional worker node pool %s: autoScalerMin %d should be at least 3 when HA zones are enabled", p.Name, p.AutoScalerMin)
+	}
+	return nil
+}
+
+func ValidateAdditionalWorkerNodePools(pools []AdditionalWorkerNodePool) error {
+	names := map[string]struct{}{}
+	for _, pool := range pools {
+		if _, exists := names[pool.Name]; exists {
+			return fmt.Errorf("additional worker node pool name %s is duplicated", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaintenanceWindowDTO defines the maintenance window chosen for the instance.
+// The time is given in UTC in the HH:MM format, empty list of days means every day of the week.
+type MaintenanceWindowDTO struct {
+	Days      []string `json:"days,omitempty"`
+	TimeBegin string   `json:"timeBegin"`
+	TimeEnd   string   `json:"timeEnd"`
+}
+
+const maintenanceWindowTimeFormat = "15:04"
+
+var maintenanceDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+func (m *MaintenanceWindowDTO) Validate() error {
+	begin, end, err := m.TimeWindow()
+	if err != nil {
+		return err
+	}
+	if begin.Equal(end) {
+		return fmt.Errorf("maintenance window begin and end time must differ")
+	}
+	for _, day := range m.Days {
+		if !slices.Contains(maintenanceDays, day) {
+			return fmt.Errorf("unrecognized maintenance day %s, allowed values: %s", day, strings.Join(maintenanceDays, ", "))
+		}
+	}
+	return nil
+}
+
+// TimeWindow returns the begin and end time of the maintenance window in UTC
+func (m *MaintenanceWindowDTO) TimeWindow() (time.Time, time.Time, error) {
+	begin, err := time.Parse(maintenanceWindowTimeFormat, m.TimeBegin)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid maintenance window begin time %s, expected format HH:MM", m.TimeBegin)
+	}
+	end, err := time.Parse(maintenanceWindowTimeFormat, m.TimeEnd)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid maintenance window end time %s, expected format HH:MM", m.TimeEnd)
+	}
+	return begin, end, nil
+}
+
+// MaintenanceDays returns days of the maintenance window, all days of the week if no days are provided
+func (m *MaintenanceWindowDTO) MaintenanceDays() []string {
+	if len(m.Days) == 0 {
+		return slices.Clone(maintenanceDays)
+	}
+	return m.Days
+}
+
 type NetworkingDTO struct {
 	NodesCidr    string  `json:"nodes,omitempty"`
 	PodsCidr     *string `json:"pods,omitempty"`
@@ -190,6 +365,22 @@ type ProvisioningParametersDTO struct {
 	Networking             *NetworkingDTO `json:"networking,omitempty"`
 	Modules                *ModulesDTO    `json:"modules,omitempty"`
 	ShootAndSeedSameRegion *bool          `json:"shootAndSeedSameRegion,omitempty"`
+
+	MaintenanceWindow *MaintenanceWindowDTO `json:"maintenanceWindow,omitempty"`
+
+	// MultiZone is set when multiple zones are enabled in the update request, the zones are kept when the runtime is provisioned again
+	MultiZone *bool `json:"multiZone,omitempty"`
+
+	// KubernetesVersion and MachineImage are accepted only for allowed global accounts and with values allowed for the plan
+	KubernetesVersion *string          `json:"kubernetesVersion,omitempty"`
+	MachineImage      *MachineImageDTO `json:"machineImage,omitempty"`
+
+	AdditionalWorkerNodePools []AdditionalWorkerNodePool `json:"additionalWorkerNodePools,omitempty"`
+}
+
+type MachineImageDTO struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
 }
 
 type UpdatingParametersDTO struct {
@@ -198,6 +389,19 @@ type UpdatingParametersDTO struct {
 	OIDC                  *OIDCConfigDTO `json:"oidc,omitempty"`
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
 	MachineType           *string        `json:"machineType,omitempty"`
+
+	// AdditionalWorkerNodePools replaces all additional worker node pools when provided, an empty list removes them.
+	// The field is not omitted when empty to keep the removal in the stored operation.
+	AdditionalWorkerNodePools []AdditionalWorkerNodePool `json:"additionalWorkerNodePools"`
+
+	// Modules replaces the modules section of the Kyma resource, the same rules as in provisioning apply
+	Modules *ModulesDTO `json:"modules,omitempty"`
+
+	MaintenanceWindow *MaintenanceWindowDTO `json:"maintenanceWindow,omitempty"`
+
+	// VolumeSizeGb can only be increased, MultiZone can only be enabled
+	VolumeSizeGb *int  `json:"volumeSizeGb,omitempty"`
+	MultiZone    *bool `json:"multiZone,omitempty"`
 }
 
 func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bool {
@@ -221,6 +425,27 @@ func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bo
 	return updated
 }
 
+func (u UpdatingParametersDTO) UpdateVolumeSizeAndZones(p *ProvisioningParametersDTO) bool {
+	updated := false
+	if u.VolumeSizeGb != nil {
+		updated = true
+		p.VolumeSizeGb = u.VolumeSizeGb
+	}
+	if u.MultiZone != nil && *u.MultiZone {
+		updated = true
+		p.MultiZone = u.MultiZone
+	}
+	return updated
+}
+
+func (u UpdatingParametersDTO) UpdateAdditionalWorkerNodePools(p *ProvisioningParametersDTO) bool {
+	if u.AdditionalWorkerNodePools == nil {
+		return false
+	}
+	p.AdditionalWorkerNodePools = append([]AdditionalWorkerNodePool{}, u.AdditionalWorkerNodePools...)
+	return true
+}
+
 type ERSContext struct {
 	TenantID              string                             `json:"tenant_id,omitempty"`
 	SubAccountID          string                             `json:"subaccount_id"`
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAdditionalWorkerNodePools(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pools []AdditionalWorkerNodePool
+		valid bool
+	}{
+		"valid pools": {
+			pools: []AdditionalWorkerNodePool{
+				{Name: "name-1", MachineType: "m6i.large", HAZones: true, AutoScalerMin: 3, AutoScalerMax: 20},
+				{Name: "name-2", MachineType: "m6i.large", HAZones: false, AutoScalerMin: 0, AutoScalerMax: 1},
+			},
+			valid: true,
+		},
+		"duplicated name": {
+			pools: []AdditionalWorkerNodePool{
+				{Name: "name-1", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2},
+				{Name: "name-1", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2},
+			},
+		},
+		"empty name": {
+			pools: []AdditionalWorkerNodePool{{Name: "", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"name with uppercase characters": {
+			pools: []AdditionalWorkerNodePool{{Name: "Name-1", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"name with invalid characters": {
+			pools: []AdditionalWorkerNodePool{{Name: "name_1", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"name starting with a hyphen": {
+			pools: []AdditionalWorkerNodePool{{Name: "-name", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"name ending with a hyphen": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"name with 15 characters": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-1234567890", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+			valid: true,
+		},
+		"name longer than 15 characters": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-12345678901", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"reserved name": {
+			pools: []AdditionalWorkerNodePool{{Name: KymaWorkerPoolName, MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+		},
+		"min greater than max": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-1", MachineType: "m6i.large", AutoScalerMin: 3, AutoScalerMax: 2}},
+		},
+		"negative min": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-1", MachineType: "m6i.large", HAZones: false, AutoScalerMin: -1, AutoScalerMax: 2}},
+		},
+		"HA zones with less than 3 nodes": {
+			pools: []AdditionalWorkerNodePool{{Name: "name-1", MachineType: "m6i.large", HAZones: true, AutoScalerMin: 2, AutoScalerMax: 5}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := ValidateAdditionalWorkerNodePools(tc.pools)
+
+			// then
+			assert.Equal(t, tc.valid, err == nil, err)
+		})
+	}
+}
+
+func TestUpdatingParametersDTO_AdditionalWorkerNodePoolsRemoval(t *testing.T) {
+	// given
+	params := UpdatingParametersDTO{AdditionalWorkerNodePools: []AdditionalWorkerNodePool{}}
+	provisioningParams := ProvisioningParametersDTO{
+		AdditionalWorkerNodePools: []AdditionalWorkerNodePool{{Name: "name-1", MachineType: "m6i.large", AutoScalerMin: 1, AutoScalerMax: 2}},
+	}
+
+	// when
+	marshaled, err := json.Marshal(params)
+	require.NoError(t, err)
+	var stored UpdatingParametersDTO
+	err = json.Unmarshal(marshaled, &stored)
+	require.NoError(t, err)
+
+	// then
+	assert.True(t, stored.UpdateAdditionalWorkerNodePools(&provisioningParams))
+	assert.Empty(t, provisioningParams.AdditionalWorkerNodePools)
+	assert.False(t, UpdatingParametersDTO{}.UpdateAdditionalWorkerNodePools(&provisioningParams))
+}
+
+func TestOIDCConfigDTO_Validate(t *testing.T) {
+	issuer := func(url, clientID string) OIDCConfigDTO {
+		return OIDCConfigDTO{IssuerURL: url, ClientID: clientID, SigningAlgs: []string{"RS256"}}
+	}
+
+	for name, tc := range map[string]struct {
+		oidc  OIDCConfigDTO
+		valid bool
+	}{
+		"single issuer": {
+			oidc:  issuer("https://issuer.local", "client-id"),
+			valid: true,
+		},
+		"single issuer with required claims": {
+			oidc: OIDCConfigDTO{IssuerURL: "https://issuer.local", ClientID: "client-id", SigningAlgs: []string{"RS256"},
+				RequiredClaims: []string{"aud=kyma", "scope=a=b"}},
+			valid: true,
+		},
+		"invalid required claim": {
+			oidc: OIDCConfigDTO{IssuerURL: "https://issuer.local", ClientID: "client-id", SigningAlgs: []string{"RS256"},
+				RequiredClaims: []string{"aud"}},
+		},
+		"list of issuers": {
+			oidc:  OIDCConfigDTO{List: []OIDCConfigDTO{issuer("https://issuer.local", "client-id"), issuer("https://other.local", "client-id")}},
+			valid: true,
+		},
+		"list with single issuer fields": {
+			oidc: OIDCConfigDTO{ClientID: "client-id", List: []OIDCConfigDTO{issuer("https://issuer.local", "client-id")}},
+		},
+		"nested list": {
+			oidc: OIDCConfigDTO{List: []OIDCConfigDTO{{List: []OIDCConfigDTO{issuer("https://issuer.local", "client-id")}}}},
+		},
+		"duplicated issuer": {
+			oidc: OIDCConfigDTO{List: []OIDCConfigDTO{issuer("https://issuer.local", "client-id"), issuer("https://issuer.local", "client-id")}},
+		},
+		"invalid issuer in the list": {
+			oidc: OIDCConfigDTO{List: []OIDCConfigDTO{issuer("https://issuer.local", "client-id"), issuer("", "client-id")}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := tc.oidc.Validate()
+
+			// then
+			assert.Equal(t, tc.valid, err == nil, err)
+		})
+	}
+}
+
+func TestOIDCConfigDTO_Issuers(t *testing.T) {
+	// given
+	single := OIDCConfigDTO{IssuerURL: "https://issuer.local", ClientID: "client-id"}
+	list := OIDCConfigDTO{List: []OIDCConfigDTO{single, {IssuerURL: "https://other.local", ClientID: "client-id"}}}
+
+	// then
+	assert.Equal(t, []OIDCConfigDTO{single}, single.Issuers())
+	assert.Equal(t, list.List, list.Issuers())
+	assert.Empty(t, (&OIDCConfigDTO{}).Issuers())
+
+	withClaims := OIDCConfigDTO{RequiredClaims: []string{"aud=kyma", "scope=a=b"}}
+	claims, err := withClaims.RequiredClaimsMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"aud": "kyma", "scope": "a=b"}, claims)
+}
+
+func TestMaintenanceWindowDTO_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		window MaintenanceWindowDTO
+		valid  bool
+	}{
+		"valid window": {
+			window: MaintenanceWindowDTO{Days: []string{"Mon", "Sat"}, TimeBegin: "22:00", TimeEnd: "02:00"},
+			valid:  true,
+		},
+		"valid window without days": {
+			window: MaintenanceWindowDTO{TimeBegin: "01:00", TimeEnd: "02:00"},
+			valid:  true,
+		},
+		"invalid day": {
+			window: MaintenanceWindowDTO{Days: []string{"Monday"}, TimeBegin: "01:00", TimeEnd: "02:00"},
+		},
+		"invalid time format": {
+			window: MaintenanceWindowDTO{TimeBegin: "010000+0000", TimeEnd: "02:00"},
+		},
+		"missing end time": {
+			window: MaintenanceWindowDTO{TimeBegin: "01:00"},
+		},
+		"same begin and end time": {
+			window: MaintenanceWindowDTO{TimeBegin: "01:00", TimeEnd: "01:00"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := tc.window.Validate()
+
+			// then
+			assert.Equal(t, tc.valid, err == nil, err)
+		})
+	}
+}
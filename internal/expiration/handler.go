@@ -67,8 +67,8 @@ func (h *handler) expireInstance(w http.ResponseWriter, req *http.Request) {
 	}
 	logger = logger.WithField("planName", instance.ServicePlanName)
 
-	if instance.ServicePlanID != broker.TrialPlanID && instance.ServicePlanID != broker.FreemiumPlanID {
-		msg := fmt.Sprintf("unsupported plan: %s", broker.PlanNamesMapping[instance.ServicePlanID])
+	if !broker.IsTrialPlan(instance.ServicePlanID) && !broker.IsFreemiumPlan(instance.ServicePlanID) {
+		msg := fmt.Sprintf("unsupported plan: %s", broker.PlanNameForID(instance.ServicePlanID))
 		logger.Warn(msg)
 		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.New(msg))
 		return
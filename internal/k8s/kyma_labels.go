@@ -2,4 +2,6 @@ package k8s
 
 const (
 	GlobalAccountIdLabel = "kyma-project.io/global-account-id"
+	PlanIdLabel          = "kyma-project.io/broker-plan-id"
+	PlanNameLabel        = "kyma-project.io/broker-plan-name"
 )
@@ -38,12 +38,18 @@ func NewBuilder(provisionerClient provisioner.Client, kcpClient client.Client, p
 }
 
 type kubeconfigData struct {
-	ContextName   string
-	CAData        string
-	ServerURL     string
-	OIDCIssuerURL string
-	OIDCClientID  string
-	Token         string
+	ContextName string
+	CAData      string
+	ServerURL   string
+	OIDCIssuers []oidcIssuer
+	Token       string
+}
+
+// oidcIssuer contains data of the kubeconfig context generated for an OIDC issuer
+type oidcIssuer struct {
+	ContextName string
+	IssuerURL   string
+	ClientID    string
 }
 
 func (b *Builder) BuildFromAdminKubeconfigForBinding(runtimeID string, token string) (string, error) {
@@ -69,9 +75,9 @@ func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKub
 	if instance.RuntimeID == "" {
 		return "", fmt.Errorf("RuntimeID must not be empty")
 	}
-	issuerURL, clientID, err := b.getOidcDataFromRuntimeResource(instance.RuntimeID)
+	issuers, err := b.getOidcDataFromRuntimeResource(instance.RuntimeID)
 	if errors.IsNotFound(err) {
-		issuerURL, clientID, err = b.getOidcDataFromProvisioner(instance)
+		issuers, err = b.getOidcDataFromProvisioner(instance)
 	}
 	if err != nil {
 		return "", fmt.Errorf("while fetching oidc data: %w", err)
@@ -92,12 +98,19 @@ func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKub
 		return "", fmt.Errorf("during unmarshal invocation: %w", err)
 	}
 
+	// the context of the primary issuer is the current context, other issuers get contexts with the issuer number
+	for i := range issuers {
+		issuers[i].ContextName = kubeCfg.CurrentContext
+		if i > 0 {
+			issuers[i].ContextName = fmt.Sprintf("%s-%d", kubeCfg.CurrentContext, i+1)
+		}
+	}
+
 	return b.parseTemplate(kubeconfigData{
-		ContextName:   kubeCfg.CurrentContext,
-		CAData:        kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
-		ServerURL:     kubeCfg.Clusters[0].Cluster.Server,
-		OIDCIssuerURL: issuerURL,
-		OIDCClientID:  clientID,
+		ContextName: kubeCfg.CurrentContext,
+		CAData:      kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
+		ServerURL:   kubeCfg.Clusters[0].Cluster.Server,
+		OIDCIssuers: issuers,
 	}, kubeconfigTemplate)
 }
 
@@ -166,25 +179,36 @@ func (b *Builder) validKubeconfig(kc kubeconfig) error {
 	return nil
 }
 
-func (b *Builder) getOidcDataFromRuntimeResource(id string) (string, string, error) {
+func (b *Builder) getOidcDataFromRuntimeResource(id string) ([]oidcIssuer, error) {
 	var runtime imv1.Runtime
 	err := b.kcpClient.Get(context.Background(), client.ObjectKey{Name: id, Namespace: kcpNamespace}, &runtime)
 	if err != nil {
-		return "", "", err
-	}
-	if runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL == nil {
-		return "", "", fmt.Errorf("Runtime Resource contains an empty OIDC issuer URL")
-	}
-	if runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID == nil {
-		return "", "", fmt.Errorf("Runtime Resource contains an empty OIDC client ID")
+		return nil, err
+	}
+	oidcConfig := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig
+	if oidcConfig.IssuerURL == nil {
+		return nil, fmt.Errorf("Runtime Resource contains an empty OIDC issuer URL")
+	}
+	if oidcConfig.ClientID == nil {
+		return nil, fmt.Errorf("Runtime Resource contains an empty OIDC client ID")
+	}
+	issuers := []oidcIssuer{{IssuerURL: *oidcConfig.IssuerURL, ClientID: *oidcConfig.ClientID}}
+	if additional := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig; additional != nil {
+		for _, oidcConfig := range *additional {
+			if oidcConfig.IssuerURL == nil || oidcConfig.ClientID == nil {
+				continue
+			}
+			issuers = append(issuers, oidcIssuer{IssuerURL: *oidcConfig.IssuerURL, ClientID: *oidcConfig.ClientID})
+		}
 	}
-	return *runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL, *runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID, nil
+	return issuers, nil
 }
 
-func (b *Builder) getOidcDataFromProvisioner(instance *internal.Instance) (string, string, error) {
+func (b *Builder) getOidcDataFromProvisioner(instance *internal.Instance) ([]oidcIssuer, error) {
 	status, err := b.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	return status.RuntimeConfiguration.ClusterConfig.OidcConfig.IssuerURL, status.RuntimeConfiguration.ClusterConfig.OidcConfig.ClientID, nil
+	oidcConfig := status.RuntimeConfiguration.ClusterConfig.OidcConfig
+	return []oidcIssuer{{IssuerURL: oidcConfig.IssuerURL, ClientID: oidcConfig.ClientID}}, nil
 }
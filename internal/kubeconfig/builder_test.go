@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -126,6 +130,59 @@ func TestBuilder_BuildFromRuntimeResource(t *testing.T) {
 	})
 }
 
+func TestBuilder_BuildWithAdditionalOIDCIssuers(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtime := &imv1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: runtimeID, Namespace: kcpNamespace}}
+	runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{IssuerURL: ptr.String(issuerURL), ClientID: ptr.String(clientID)}
+	runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = &[]gardener.OIDCConfig{
+		{IssuerURL: ptr.String("https://corporate.example.com"), ClientID: ptr.String("corporate-client")},
+	}
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtime).Build()
+	builder := NewBuilder(&automock.Client{}, kcpClient, NewFakeKubeconfigProvider(skrKubeconfig()))
+
+	instance := &internal.Instance{
+		RuntimeID:       runtimeID,
+		GlobalAccountID: globalAccountID,
+	}
+
+	// when
+	kubeconfig, err := builder.Build(instance)
+
+	// then
+	require.NoError(t, err)
+	var generated struct {
+		CurrentContext string `yaml:"current-context"`
+		Contexts       []struct {
+			Name    string `yaml:"name"`
+			Context struct {
+				Cluster string `yaml:"cluster"`
+				User    string `yaml:"user"`
+			} `yaml:"context"`
+		} `yaml:"contexts"`
+		Users []struct {
+			Name string `yaml:"name"`
+			User struct {
+				Exec struct {
+					Args []string `yaml:"args"`
+				} `yaml:"exec"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(kubeconfig), &generated))
+	assert.Equal(t, "shoot--kyma-dev--ac0d8d9", generated.CurrentContext)
+	require.Len(t, generated.Contexts, 2)
+	assert.Equal(t, "shoot--kyma-dev--ac0d8d9", generated.Contexts[0].Name)
+	assert.Equal(t, "shoot--kyma-dev--ac0d8d9-2", generated.Contexts[1].Name)
+	assert.Equal(t, "shoot--kyma-dev--ac0d8d9", generated.Contexts[1].Context.Cluster)
+	assert.Equal(t, "shoot--kyma-dev--ac0d8d9-2", generated.Contexts[1].Context.User)
+	require.Len(t, generated.Users, 2)
+	assert.Contains(t, generated.Users[0].User.Exec.Args, fmt.Sprintf("--oidc-issuer-url=%s", issuerURL))
+	assert.Contains(t, generated.Users[1].User.Exec.Args, "--oidc-issuer-url=https://corporate.example.com")
+	assert.Contains(t, generated.Users[1].User.Exec.Args, "--oidc-client-id=corporate-client")
+}
+
 func TestBuilder_BuildFromAdminKubeconfig(t *testing.T) {
 	err := imv1.AddToScheme(scheme.Scheme)
 	assert.NoError(t, err)
@@ -35,19 +35,22 @@ clusters:
     certificate-authority-data: {{ .CAData }}
     server: {{ .ServerURL }}
 contexts:
+{{- range .OIDCIssuers }}
 - name: {{ .ContextName }}
   context:
-    cluster: {{ .ContextName }}
+    cluster: {{ $.ContextName }}
     user: {{ .ContextName }}
+{{- end }}
 users:
+{{- range .OIDCIssuers }}
 - name: {{ .ContextName }}
   user:
     exec:
       apiVersion: client.authentication.k8s.io/v1beta1
       args:
       - get-token
-      - "--oidc-issuer-url={{ .OIDCIssuerURL }}"
-      - "--oidc-client-id={{ .OIDCClientID }}"
+      - "--oidc-issuer-url={{ .IssuerURL }}"
+      - "--oidc-client-id={{ .ClientID }}"
       - "--oidc-extra-scope=email"
       - "--oidc-extra-scope=openid"
       command: kubectl-oidc_login
@@ -61,6 +64,7 @@ users:
 
         # Chocolatey (Windows)
         choco install kubelogin
+{{- end }}
 `
 
 const kubeconfigTemplateForKymaBindings = `
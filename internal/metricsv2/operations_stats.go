@@ -253,6 +253,8 @@ func formatOpType(opType internal.OperationType) string {
 		return "updating"
 	case internal.OperationTypeUpgradeCluster:
 		return "upgrading_cluster"
+	case internal.OperationTypeUpgradeKyma:
+		return "upgrading_kyma"
 	default:
 		return ""
 	}
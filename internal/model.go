@@ -20,6 +20,11 @@ import (
 const BINDING_TYPE_SERVICE_ACCOUNT = "service_account"
 const BINDING_TYPE_ADMIN_KUBECONFIG = "gardener_admin_kubeconfig"
 
+// role profiles granted to the service account created for a binding
+const BINDING_ROLE_CLUSTER_ADMIN = "cluster-admin"
+const BINDING_ROLE_NAMESPACE_ADMIN = "namespace-admin"
+const BINDING_ROLE_READ_ONLY = "read-only"
+
 type ProvisionerInputCreator interface {
 	SetProvisioningParameters(params ProvisioningParameters) ProvisionerInputCreator
 	SetShootName(string) ProvisionerInputCreator
@@ -158,6 +163,8 @@ type Operation struct {
 
 	// UPDATING
 	UpdatingParameters UpdatingParametersDTO `json:"updating_parameters"`
+	// PreviousPlanID is set when the update operation changes the plan of the instance
+	PreviousPlanID string `json:"previous_plan_id,omitempty"`
 
 	// UPGRADE KYMA
 	orchestration.RuntimeOperation `json:"runtime_operation"`
@@ -492,14 +499,22 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 		op.ProvisioningParameters.Parameters.OIDC = updatingParams.OIDC
 	}
 
-	if len(updatingParams.RuntimeAdministrators) != 0 {
+	if updatingParams.RuntimeAdministrators != nil {
 		op.ProvisioningParameters.Parameters.RuntimeAdministrators = updatingParams.RuntimeAdministrators
 	}
 
 	updatingParams.UpdateAutoScaler(&op.ProvisioningParameters.Parameters)
+	updatingParams.UpdateAdditionalWorkerNodePools(&op.ProvisioningParameters.Parameters)
+	updatingParams.UpdateVolumeSizeAndZones(&op.ProvisioningParameters.Parameters)
 	if updatingParams.MachineType != nil && *updatingParams.MachineType != "" {
 		op.ProvisioningParameters.Parameters.MachineType = updatingParams.MachineType
 	}
+	if updatingParams.Modules != nil {
+		op.ProvisioningParameters.Parameters.Modules = updatingParams.Modules
+	}
+	if updatingParams.MaintenanceWindow != nil {
+		op.ProvisioningParameters.Parameters.MaintenanceWindow = updatingParams.MaintenanceWindow
+	}
 
 	return op
 }
@@ -589,9 +604,41 @@ type Binding struct {
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	ExpiresAt time.Time
 
 	Kubeconfig        string
 	ExpirationSeconds int64
 	GenerationMethod  string
 	BindingType       string
+
+	// the role profile and the namespace it is granted in, the namespace is empty for cluster wide roles
+	Role      string
+	Namespace string
+
+	// the last operation processed for the binding, bind and unbind are processed asynchronously when the platform allows it
+	OperationType        BindingOperationType
+	OperationState       domain.LastOperationState
+	OperationDescription string
+}
+
+type BindingOperationType string
+
+const (
+	BindingOperationTypeBind   BindingOperationType = "bind"
+	BindingOperationTypeUnbind BindingOperationType = "unbind"
+)
+
+func (b *Binding) IsBound() bool {
+	return b.OperationType == BindingOperationTypeBind && b.OperationState == domain.Succeeded
+}
+
+// QueueLease is a claim of a queued item taken by one broker replica, the item is processed only by the lease owner
+type QueueLease struct {
+	QueueName string
+	ItemID    string
+	Owner     string
+
+	// Generation is increased every time the item is added to the queue,
+	// the processed item is removed from the queue only if it was not added again while processed
+	Generation int64
 }
@@ -4,6 +4,14 @@ const (
 	DefaultNodesCIDR    = "10.250.0.0/22"
 	DefaultPodsCIDR     = "10.96.0.0/13"
 	DefaultServicesCIDR = "10.104.0.0/13"
+
+	// MaxNodesPrefixLength, MaxPodsPrefixLength and MaxServicesPrefixLength are the largest suffixes (the smallest ranges) allowed for the CIDRs
+	MaxNodesPrefixLength    = 23
+	MaxPodsPrefixLength     = 24
+	MaxServicesPrefixLength = 24
 )
 
 var GardenerSeedCIDRs = []string{"10.243.128.0/17", "10.242.0.0/16", "10.243.0.0/17", "10.64.0.0/11", "10.254.0.0/16", "10.243.0.0/16"}
+
+// PrivateCIDRs are the private address ranges defined in RFC 1918, all networking CIDRs must be within one of them
+var PrivateCIDRs = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,21 +12,22 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/httputil"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
-	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type clusterHandler struct {
 	orchestrations storage.Orchestrations
 	queue          *process.Queue
+	previewer      TargetsPreviewer
 	converter      Converter
 	log            logrus.FieldLogger
 }
 
-func NewClusterHandler(orchestrations storage.Orchestrations, q *process.Queue, log logrus.FieldLogger) *clusterHandler {
+func NewClusterHandler(orchestrations storage.Orchestrations, q *process.Queue, previewer TargetsPreviewer, log logrus.FieldLogger) *clusterHandler {
 	return &clusterHandler{
 		orchestrations: orchestrations,
 		queue:          q,
+		previewer:      previewer,
 		log:            log,
 		converter:      Converter{},
 	}
@@ -38,38 +38,11 @@ func (h *clusterHandler) AttachRoutes(router *mux.Router) {
 }
 
 func (h *clusterHandler) createOrchestration(w http.ResponseWriter, r *http.Request) {
-	// validate request body
-	params := orchestration.Parameters{}
-	if r.Body != nil {
-		err := json.NewDecoder(r.Body).Decode(&params)
-		if err != nil {
-			h.log.Errorf("while decoding request body: %v", err)
-			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
-			return
-		}
-	}
-
-	// validate target
-	err := validateTarget(params.Targets)
-	if err != nil {
-		h.log.Errorf("while validating target: %v", err)
-		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating target: %w", err))
+	params, ok := decodeAndValidateParameters(w, r, h.log)
+	if !ok {
 		return
 	}
-
-	// validate deprecated parameteter `maintenanceWindow`
-	err = ValidateDeprecatedParameters(params)
-	if err != nil {
-		h.log.Errorf("found deprecated value: %v", err)
-		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "found deprecated value"))
-		return
-	}
-
-	// validate `schedule` field
-	err = ValidateScheduleParameter(&params)
-	if err != nil {
-		h.log.Errorf("found deprecated value: %v", err)
-		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "found deprecated value"))
+	if !validateCanaryTargets(w, h.previewer, params, h.log) {
 		return
 	}
 
@@ -84,7 +57,7 @@ func (h *clusterHandler) createOrchestration(w http.ResponseWriter, r *http.Requ
 		UpdatedAt:       now,
 	}
 
-	err = h.orchestrations.Insert(o)
+	err := h.orchestrations.Insert(o)
 	if err != nil {
 		h.log.Errorf("while inserting orchestration to storage: %v", err)
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while inserting orchestration to storage: %w", err))
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gorilla/mux"
@@ -21,7 +22,7 @@ import (
 func TestClusterHandler_AttachRoutes(t *testing.T) {
 	t.Run("upgrade", func(t *testing.T) {
 		// given
-		handler := fixClusterHandler(t)
+		handler := fixClusterHandler(storage.NewMemoryStorage(), &fakePreviewer{})
 
 		params := orchestration.Parameters{
 			Targets: orchestration.TargetSpec{
@@ -57,13 +58,159 @@ func TestClusterHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, out.OrchestrationID)
 	})
+
+	t.Run("upgrade with invalid canary strategy", func(t *testing.T) {
+		// given
+		handler := fixClusterHandler(storage.NewMemoryStorage(), &fakePreviewer{})
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						RuntimeID: "test",
+					},
+				},
+			},
+			Strategy: orchestration.StrategySpec{
+				Type:     orchestration.CanaryStrategy,
+				Schedule: "now",
+				Canary:   &orchestration.CanaryStrategySpec{Percentage: 10, SoakPeriod: "two hours"},
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/cluster", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		handler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "invalid canary soakPeriod")
+	})
+
+	t.Run("upgrade with invalid schedule", func(t *testing.T) {
+		// given
+		handler := fixClusterHandler(storage.NewMemoryStorage(), &fakePreviewer{})
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						RuntimeID: "test",
+					},
+				},
+			},
+			Strategy: orchestration.StrategySpec{
+				Schedule: "tomorrow",
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/cluster", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		handler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "while validating schedule")
+	})
+
+	t.Run("upgrade with canary runtime not targeted", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		handler := fixClusterHandler(db, &fakePreviewer{runtimes: []orchestration.RuntimePreview{
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-1"}},
+		}})
+
+		params := fixCanaryClusterUpgradeParameters("runtime-id-1", "runtime-id-2")
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/cluster", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		handler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "runtime-id-2")
+		assert.NotContains(t, rr.Body.String(), "runtime-id-1")
+
+		orchestrations, _, _, err := db.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, orchestrations)
+	})
+
+	t.Run("upgrade with canary runtime targeted", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		handler := fixClusterHandler(db, &fakePreviewer{runtimes: []orchestration.RuntimePreview{
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-1"}},
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-2"}},
+		}})
+
+		params := fixCanaryClusterUpgradeParameters("runtime-id-1")
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/cluster", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		handler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		orchestrations, _, _, err := db.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		assert.Len(t, orchestrations, 1)
+	})
+}
+
+func fixCanaryClusterUpgradeParameters(canaryRuntimeIDs ...string) orchestration.Parameters {
+	return orchestration.Parameters{
+		Targets: orchestration.TargetSpec{
+			Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+		},
+		Strategy: orchestration.StrategySpec{
+			Type:     orchestration.CanaryStrategy,
+			Schedule: "now",
+			Canary: &orchestration.CanaryStrategySpec{
+				RuntimeIDs: canaryRuntimeIDs,
+				SoakPeriod: "1h",
+			},
+		},
+	}
 }
 
-func fixClusterHandler(t *testing.T) *clusterHandler {
-	db := storage.NewMemoryStorage()
+func fixClusterHandler(db storage.BrokerStorage, previewer TargetsPreviewer) *clusterHandler {
 	logs := logrus.New()
 	q := process.NewQueue(&testExecutor{}, logs)
-	handler := NewClusterHandler(db.Orchestrations(), q, logs)
+	handler := NewClusterHandler(db.Orchestrations(), q, previewer, logs)
 
 	return handler
 }
@@ -42,24 +42,28 @@ func (c *Converter) OrchestrationListToDTO(orchestrations []internal.Orchestrati
 	}, nil
 }
 
-func (c *Converter) UpgradeClusterOperationToDTO(op internal.UpgradeClusterOperation) (orchestration.OperationResponse, error) {
+func (c *Converter) OperationToDTO(op internal.Operation) (orchestration.OperationResponse, error) {
 	return orchestration.OperationResponse{
-		OperationID:            op.Operation.ID,
+		OperationID:            op.ID,
 		RuntimeID:              op.RuntimeOperation.RuntimeID,
 		GlobalAccountID:        op.GlobalAccountID,
 		SubAccountID:           op.RuntimeOperation.SubAccountID,
 		OrchestrationID:        op.OrchestrationID,
 		ServicePlanID:          op.ProvisioningParameters.PlanID,
-		ServicePlanName:        broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		ServicePlanName:        broker.PlanNameForID(op.ProvisioningParameters.PlanID),
 		DryRun:                 op.DryRun,
 		ShootName:              op.RuntimeOperation.ShootName,
 		MaintenanceWindowBegin: op.MaintenanceWindowBegin,
 		MaintenanceWindowEnd:   op.MaintenanceWindowEnd,
-		State:                  string(op.Operation.State),
-		Description:            op.Operation.Description,
+		State:                  string(op.State),
+		Description:            op.Description,
 	}, nil
 }
 
+func (c *Converter) UpgradeClusterOperationToDTO(op internal.UpgradeClusterOperation) (orchestration.OperationResponse, error) {
+	return c.OperationToDTO(op.Operation)
+}
+
 func (c *Converter) UpgradeClusterOperationListToDTO(ops []internal.UpgradeClusterOperation, count, totalCount int) (orchestration.OperationResponseList, error) {
 	data := make([]orchestration.OperationResponse, 0, len(ops))
 
@@ -88,3 +92,31 @@ func (c *Converter) UpgradeClusterOperationToDetailDTO(op internal.UpgradeCluste
 		ClusterConfig:     clusterConfig,
 	}, nil
 }
+
+func (c *Converter) UpgradeKymaOperationListToDTO(ops []internal.Operation, count, totalCount int) (orchestration.OperationResponseList, error) {
+	data := make([]orchestration.OperationResponse, 0, len(ops))
+
+	for _, op := range ops {
+		o, err := c.OperationToDTO(op)
+		if err != nil {
+			return orchestration.OperationResponseList{}, fmt.Errorf("while converting operation to DTO: %w", err)
+		}
+		data = append(data, o)
+	}
+
+	return orchestration.OperationResponseList{
+		Data:       data,
+		Count:      count,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (c *Converter) UpgradeKymaOperationToDetailDTO(op internal.Operation) (orchestration.OperationDetailResponse, error) {
+	resp, err := c.OperationToDTO(op)
+	if err != nil {
+		return orchestration.OperationDetailResponse{}, fmt.Errorf("while converting operation to DTO: %w", err)
+	}
+	return orchestration.OperationDetailResponse{
+		OperationResponse: resp,
+	}, nil
+}
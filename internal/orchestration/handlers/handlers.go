@@ -1,11 +1,15 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/httputil"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/pkg/errors"
@@ -20,12 +24,13 @@ type handler struct {
 	handlers []Handler
 }
 
-func NewOrchestrationHandler(db storage.BrokerStorage, clusterQueue *process.Queue, defaultMaxPage int, log logrus.FieldLogger) Handler {
+func NewOrchestrationHandler(db storage.BrokerStorage, clusterQueue *process.Queue, kymaQueue *process.Queue, previewer TargetsPreviewer, defaultMaxPage int, log logrus.FieldLogger) Handler {
 	return &handler{
 		handlers: []Handler{
-			NewKymaHandler(),
-			NewClusterHandler(db.Orchestrations(), clusterQueue, log),
-			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, defaultMaxPage, log),
+			NewKymaHandler(db.Orchestrations(), kymaQueue, previewer, log),
+			NewClusterHandler(db.Orchestrations(), clusterQueue, previewer, log),
+			NewPreviewHandler(previewer, log),
+			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, kymaQueue, defaultMaxPage, log),
 		},
 	}
 }
@@ -36,10 +41,59 @@ func (h *handler) AttachRoutes(router *mux.Router) {
 	}
 }
 
+// decodeAndValidateParameters decodes the orchestration parameters from the request body and validates the targets, the schedule, and the strategy.
+// The error response is written if the parameters are not valid, false is returned in such case.
+func decodeAndValidateParameters(w http.ResponseWriter, r *http.Request, log logrus.FieldLogger) (orchestration.Parameters, bool) {
+	params := orchestration.Parameters{}
+	if r.Body != nil {
+		err := json.NewDecoder(r.Body).Decode(&params)
+		if err != nil {
+			log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+			return params, false
+		}
+	}
+
+	err := validateTarget(params.Targets)
+	if err != nil {
+		log.Errorf("while validating target: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating target: %w", err))
+		return params, false
+	}
+
+	// validate deprecated parameteter `maintenanceWindow`
+	err = ValidateDeprecatedParameters(params)
+	if err != nil {
+		log.Errorf("found deprecated value: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "found deprecated value"))
+		return params, false
+	}
+
+	err = ValidateScheduleParameter(&params)
+	if err != nil {
+		log.Errorf("while validating schedule: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating schedule: %w", err))
+		return params, false
+	}
+
+	err = ValidateStrategyParameter(params)
+	if err != nil {
+		log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating strategy: %w", err))
+		return params, false
+	}
+	return params, true
+}
+
 func validateTarget(spec orchestration.TargetSpec) error {
 	if spec.Include == nil || len(spec.Include) == 0 {
 		return errors.New("targets.include array must be not empty")
 	}
+	for _, rt := range append(spec.Include, spec.Exclude...) {
+		if err := rt.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -67,3 +121,53 @@ func ValidateScheduleParameter(params *orchestration.Parameters) error {
 	}
 	return nil
 }
+
+// ValidateStrategyParameter checks if parameters of the canary strategy are provided and valid.
+func ValidateStrategyParameter(params orchestration.Parameters) error {
+	if params.Strategy.Type != orchestration.CanaryStrategy {
+		return nil
+	}
+	if params.Strategy.Canary == nil {
+		return fmt.Errorf("strategy.canary must be provided for the canary strategy")
+	}
+	return params.Strategy.Canary.Validate()
+}
+
+// canaryRuntimesNotTargeted returns runtime IDs of the canary wave which are not among the resolved orchestration targets
+func canaryRuntimesNotTargeted(previewer TargetsPreviewer, params orchestration.Parameters) ([]string, error) {
+	if params.Strategy.Type != orchestration.CanaryStrategy || len(params.Strategy.Canary.RuntimeIDs) == 0 {
+		return nil, nil
+	}
+	runtimes, err := previewer.Preview(params)
+	if err != nil {
+		return nil, err
+	}
+	targeted := make(map[string]struct{}, len(runtimes))
+	for _, rt := range runtimes {
+		targeted[rt.RuntimeID] = struct{}{}
+	}
+	var notTargeted []string
+	for _, id := range params.Strategy.Canary.RuntimeIDs {
+		if _, found := targeted[id]; !found {
+			notTargeted = append(notTargeted, id)
+		}
+	}
+	return notTargeted, nil
+}
+
+// validateCanaryTargets writes the error response if the canary runtimes are not targeted by the orchestration, returns false in such case
+func validateCanaryTargets(w http.ResponseWriter, previewer TargetsPreviewer, params orchestration.Parameters, log logrus.FieldLogger) bool {
+	notTargeted, err := canaryRuntimesNotTargeted(previewer, params)
+	if err != nil {
+		log.Errorf("while resolving orchestration targets: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while resolving orchestration targets: %w", err))
+		return false
+	}
+	if len(notTargeted) > 0 {
+		err = fmt.Errorf("canary runtimeIDs %s do not match any orchestration target", strings.Join(notTargeted, ", "))
+		log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating strategy: %w", err))
+		return false
+	}
+	return true
+}
@@ -3,15 +3,34 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
 )
 
-type kymaHandler struct{}
+type kymaHandler struct {
+	orchestrations storage.Orchestrations
+	queue          *process.Queue
+	previewer      TargetsPreviewer
+	converter      Converter
+	log            logrus.FieldLogger
+}
 
-func NewKymaHandler() *kymaHandler {
-	return &kymaHandler{}
+func NewKymaHandler(orchestrations storage.Orchestrations, q *process.Queue, previewer TargetsPreviewer, log logrus.FieldLogger) *kymaHandler {
+	return &kymaHandler{
+		orchestrations: orchestrations,
+		queue:          q,
+		previewer:      previewer,
+		log:            log,
+		converter:      Converter{},
+	}
 }
 
 func (h *kymaHandler) AttachRoutes(router *mux.Router) {
@@ -19,5 +38,48 @@ func (h *kymaHandler) AttachRoutes(router *mux.Router) {
 }
 
 func (h *kymaHandler) createOrchestration(w http.ResponseWriter, r *http.Request) {
-	httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("kyma upgrade not supported"))
+	params, ok := decodeAndValidateParameters(w, r, h.log)
+	if !ok {
+		return
+	}
+	if !validateCanaryTargets(w, h.previewer, params, h.log) {
+		return
+	}
+
+	// validate `kyma` field
+	if params.Kyma == nil {
+		h.log.Errorf("kyma parameters not provided")
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("kyma parameters must be provided"))
+		return
+	}
+	err := params.Kyma.Validate()
+	if err != nil {
+		h.log.Errorf("while validating kyma parameters: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating kyma parameters: %w", err))
+		return
+	}
+
+	now := time.Now()
+	o := internal.Orchestration{
+		OrchestrationID: uuid.New().String(),
+		Type:            orchestration.UpgradeKymaOrchestration,
+		State:           orchestration.Pending,
+		Description:     "queued for processing",
+		Parameters:      params,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	err = h.orchestrations.Insert(o)
+	if err != nil {
+		h.log.Errorf("while inserting orchestration to storage: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while inserting orchestration to storage: %w", err))
+		return
+	}
+
+	h.queue.Add(o.OrchestrationID)
+
+	response := orchestration.UpgradeResponse{OrchestrationID: o.OrchestrationID}
+
+	httputil.WriteResponse(w, http.StatusAccepted, response)
 }
@@ -9,13 +9,19 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestKymaHandler_AttachRoutes(t *testing.T) {
 	t.Run("upgrade", func(t *testing.T) {
 		// given
-		kHandler := NewKymaHandler()
+		db := storage.NewMemoryStorage()
+		kHandler := fixKymaHandler(db, &fakePreviewer{})
 
 		params := orchestration.Parameters{
 			Targets: orchestration.TargetSpec{
@@ -25,8 +31,9 @@ func TestKymaHandler_AttachRoutes(t *testing.T) {
 					},
 				},
 			},
-			Kubernetes: &orchestration.KubernetesParameters{
-				KubernetesVersion: "",
+			Kyma: &orchestration.KymaParameters{
+				Channel: "fast",
+				Modules: []orchestration.KymaModule{{Name: "keda", Channel: "regular"}},
 			},
 			Strategy: orchestration.StrategySpec{
 				Schedule: "now",
@@ -45,7 +52,168 @@ func TestKymaHandler_AttachRoutes(t *testing.T) {
 		// when
 		router.ServeHTTP(rr, req)
 
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		var out orchestration.UpgradeResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		o, err := db.Orchestrations().GetByID(out.OrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.UpgradeKymaOrchestration, o.Type)
+		assert.Equal(t, "fast", o.Parameters.Kyma.Channel)
+	})
+
+	t.Run("upgrade without kyma parameters", func(t *testing.T) {
+		// given
+		kHandler := fixKymaHandler(storage.NewMemoryStorage(), &fakePreviewer{})
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						RuntimeID: "test",
+					},
+				},
+			},
+			Kyma: &orchestration.KymaParameters{},
+			Strategy: orchestration.StrategySpec{
+				Schedule: "now",
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/kyma", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
 		// then
 		require.Equal(t, http.StatusBadRequest, rr.Code)
 	})
+
+	t.Run("upgrade with invalid label selector", func(t *testing.T) {
+		// given
+		kHandler := fixKymaHandler(storage.NewMemoryStorage(), &fakePreviewer{})
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						LabelSelector: "kyma-project.io/platform-region in (cf-eu10",
+					},
+				},
+			},
+			Kyma: &orchestration.KymaParameters{
+				Channel: "fast",
+			},
+			Strategy: orchestration.StrategySpec{
+				Schedule: "now",
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/kyma", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("upgrade with canary runtime not targeted", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		kHandler := fixKymaHandler(db, &fakePreviewer{runtimes: []orchestration.RuntimePreview{
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-1"}},
+		}})
+
+		params := fixCanaryUpgradeParameters("runtime-id-1", "runtime-id-2")
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/kyma", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "runtime-id-2")
+		assert.NotContains(t, rr.Body.String(), "runtime-id-1")
+
+		orchestrations, _, _, err := db.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, orchestrations)
+	})
+
+	t.Run("upgrade with canary runtime targeted", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		kHandler := fixKymaHandler(db, &fakePreviewer{runtimes: []orchestration.RuntimePreview{
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-1"}},
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-2"}},
+		}})
+
+		params := fixCanaryUpgradeParameters("runtime-id-1")
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/kyma", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	})
+}
+
+func fixCanaryUpgradeParameters(canaryRuntimeIDs ...string) orchestration.Parameters {
+	return orchestration.Parameters{
+		Targets: orchestration.TargetSpec{
+			Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+		},
+		Kyma: &orchestration.KymaParameters{
+			Channel: "fast",
+		},
+		Strategy: orchestration.StrategySpec{
+			Type:     orchestration.CanaryStrategy,
+			Schedule: "now",
+			Canary: &orchestration.CanaryStrategySpec{
+				RuntimeIDs: canaryRuntimeIDs,
+				SoakPeriod: "1h",
+			},
+		},
+	}
+}
+
+func fixKymaHandler(db storage.BrokerStorage, previewer TargetsPreviewer) *kymaHandler {
+	logs := logrus.New()
+	q := process.NewQueue(&testExecutor{}, logs)
+	return NewKymaHandler(db.Orchestrations(), q, previewer, logs)
 }
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+
+	commonOrchestration "github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type kymaRetryer Retryer
+
+func NewKymaRetryer(orchestrations storage.Orchestrations, operations storage.Operations, q *process.Queue, logger logrus.FieldLogger) *kymaRetryer {
+	return &kymaRetryer{
+		orchestrations: orchestrations,
+		operations:     operations,
+		queue:          q,
+		log:            logger,
+	}
+}
+
+func (r *kymaRetryer) orchestrationRetry(o *internal.Orchestration, opsByOrch []internal.Operation, operationIDs []string) (commonOrchestration.RetryResponse, error) {
+	resp := commonOrchestration.RetryResponse{OrchestrationID: o.OrchestrationID}
+
+	ops, invalidIDs := r.orchestrationOperationsFilter(opsByOrch, operationIDs)
+	resp.InvalidOperations = invalidIDs
+	if len(ops) == 0 {
+		zeroValidOperationInfo(&resp, r.log)
+		return resp, nil
+	}
+
+	// as failed orchestration has finished before
+	// only retry the latest failed kyma upgrade operation for the same instance
+	if o.State == commonOrchestration.Failed {
+		var oldIDs []string
+		var err error
+
+		ops, oldIDs, err = r.latestOperationValidate(ops)
+		if err != nil {
+			return resp, err
+		}
+		resp.OldOperations = oldIDs
+
+		if len(ops) == 0 {
+			zeroValidOperationInfo(&resp, r.log)
+			return resp, nil
+		}
+	}
+
+	for _, op := range ops {
+		resp.RetryShoots = append(resp.RetryShoots, op.InstanceDetails.ShootName)
+		o.Parameters.RetryOperation.RetryOperations = append(o.Parameters.RetryOperation.RetryOperations, op.ID)
+	}
+	resp.Msg = "retry operations are queued for processing"
+
+	// get orchestration state again in case in progress changed to failed, need to put in queue
+	lastState, err := orchestrationStateUpdate(o, r.orchestrations, o.OrchestrationID, r.log)
+	if err != nil {
+		return resp, err
+	}
+
+	if lastState == commonOrchestration.Failed {
+		r.queue.Add(o.OrchestrationID)
+	}
+
+	return resp, nil
+}
+
+func (r *kymaRetryer) orchestrationOperationsFilter(opsByOrch []internal.Operation, opsIDs []string) ([]internal.Operation, []string) {
+	if len(opsIDs) <= 0 {
+		return opsByOrch, nil
+	}
+
+	var retOps []internal.Operation
+	var invalidIDs []string
+
+	for _, opID := range opsIDs {
+		idx := slices.IndexFunc(opsByOrch, func(op internal.Operation) bool { return op.ID == opID })
+		if idx < 0 {
+			invalidIDs = append(invalidIDs, opID)
+			continue
+		}
+		retOps = append(retOps, opsByOrch[idx])
+	}
+
+	return retOps, invalidIDs
+}
+
+// latestOperationValidate drops operations for which a newer kyma upgrade operation of the same instance exists
+func (r *kymaRetryer) latestOperationValidate(ops []internal.Operation) ([]internal.Operation, []string, error) {
+	var retryOps []internal.Operation
+	var oldIDs []string
+
+	for _, op := range ops {
+		instanceOps, err := r.operations.ListOperationsByInstanceID(op.InstanceID)
+		if err != nil {
+			err = fmt.Errorf("while getting operations by instanceID %s: %w", op.InstanceID, err)
+			r.log.Error(err)
+			return nil, nil, err
+		}
+
+		newerExist := slices.ContainsFunc(instanceOps, func(other internal.Operation) bool {
+			// 'canceled' or 'canceling' newer op is not a newer op
+			return other.Type == internal.OperationTypeUpgradeKyma && op.CreatedAt.Before(other.CreatedAt) &&
+				other.State != commonOrchestration.Canceled && other.State != commonOrchestration.Canceling
+		})
+		if newerExist {
+			oldIDs = append(oldIDs, op.ID)
+			continue
+		}
+
+		retryOps = append(retryOps, op)
+	}
+
+	return retryOps, oldIDs, nil
+}
@@ -31,7 +31,9 @@ type orchestrationHandler struct {
 	log       logrus.FieldLogger
 
 	canceler       *Canceler
+	pauser         *Pauser
 	clusterRetryer *clusterRetryer
+	kymaRetryer    *kymaRetryer
 
 	defaultMaxPage int
 }
@@ -41,6 +43,7 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 	orchestrations storage.Orchestrations,
 	runtimeStates storage.RuntimeStates,
 	clusterQueue *process.Queue,
+	kymaQueue *process.Queue,
 	defaultMaxPage int,
 	log logrus.FieldLogger) *orchestrationHandler {
 	return &orchestrationHandler{
@@ -51,7 +54,9 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 		defaultMaxPage: defaultMaxPage,
 		converter:      Converter{},
 		canceler:       NewCanceler(orchestrations, log),
+		pauser:         NewPauser(orchestrations, log),
 		clusterRetryer: NewClusterRetryer(orchestrations, operations, clusterQueue, log),
+		kymaRetryer:    NewKymaRetryer(orchestrations, operations, kymaQueue, log),
 	}
 }
 
@@ -59,6 +64,8 @@ func (h *orchestrationHandler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/orchestrations", h.listOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}", h.getOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/cancel", h.cancelOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/pause", h.pauseOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/resume", h.resumeOrchestrationByID).Methods(http.MethodPut)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations", h.listOperations).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations/{operation_id}", h.getOperation).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/retry", h.retryOrchestrationByID).Methods(http.MethodPost)
@@ -106,6 +113,36 @@ func (h *orchestrationHandler) cancelOrchestrationByID(w http.ResponseWriter, r
 	httputil.WriteResponse(w, http.StatusOK, response)
 }
 
+func (h *orchestrationHandler) pauseOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.PauseForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while pausing orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while pausing orchestration %s: %w", orchestrationID, err))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *orchestrationHandler) resumeOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.ResumeForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while resuming orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while resuming orchestration %s: %w", orchestrationID, err))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
 func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *http.Request) {
 	contentType := r.Header.Get("Content-type")
 	if contentType != "application/x-www-form-urlencoded" {
@@ -155,6 +192,20 @@ func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *
 			return
 		}
 
+	case commonOrchestration.UpgradeKymaOrchestration:
+		allOps, _, _, err := h.operations.ListOperationsByOrchestrationID(o.OrchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting operations: %w", err))
+			return
+		}
+
+		response, err = h.kymaRetryer.orchestrationRetry(o, allOps, operationIDs)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -233,6 +284,20 @@ func (h *orchestrationHandler) listOperations(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+	case commonOrchestration.UpgradeKymaOrchestration:
+		operations, count, totalCount, err := h.operations.ListOperationsByOrchestrationID(orchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting operations: %w", err))
+			return
+		}
+		response, err = h.converter.UpgradeKymaOperationListToDTO(operations, count, totalCount)
+		if err != nil {
+			h.log.Errorf("while converting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while converting operations: %w", err))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -275,6 +340,21 @@ func (h *orchestrationHandler) getOperation(w http.ResponseWriter, r *http.Reque
 			return
 		}
 
+	case commonOrchestration.UpgradeKymaOrchestration:
+		operation, err := h.operations.GetOperationByID(operationID)
+		if err != nil {
+			h.log.Errorf("while getting upgrade operation %s: %v", operationID, err)
+			httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while getting upgrade operation %s: %w", operationID, err))
+			return
+		}
+
+		response, err = h.converter.UpgradeKymaOperationToDetailDTO(*operation)
+		if err != nil {
+			h.log.Errorf("while converting operation: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while converting operation: %w", err))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -34,7 +34,7 @@ func TestStatusHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 
 		logs := logrus.New()
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, nil, 100, logs)
 
 		req, err := http.NewRequest("GET", "/orchestrations?page_size=1", nil)
 		require.NoError(t, err)
@@ -142,7 +142,7 @@ func TestStatusHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 
 		logs := logrus.New()
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, nil, 100, logs)
 
 		urlPath := fmt.Sprintf("/orchestrations/%s/operations", fixID)
 		req, err := http.NewRequest("GET", urlPath, nil)
@@ -186,6 +186,125 @@ func TestStatusHandler_AttachRoutes(t *testing.T) {
 		assert.Equal(t, dto.OperationID, fixID)
 	})
 
+	t.Run("kyma upgrade operations", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+
+		err := db.Orchestrations().Insert(internal.Orchestration{OrchestrationID: fixID, Type: orchestration.UpgradeKymaOrchestration})
+		require.NoError(t, err)
+		err = db.Operations().InsertOperation(internal.Operation{
+			ID:              fixID,
+			InstanceID:      fixID,
+			OrchestrationID: fixID,
+			Type:            internal.OperationTypeUpgradeKyma,
+			State:           domain.Succeeded,
+			RuntimeOperation: orchestration.RuntimeOperation{
+				ID: fixID,
+			},
+		})
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, nil, 100, logs)
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("/orchestrations/%s/operations", fixID), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kymaHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out orchestration.OperationResponseList
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		require.Len(t, out.Data, 1)
+		assert.Equal(t, fixID, out.Data[0].OperationID)
+
+		// given
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/orchestrations/%s/operations/%s", fixID, fixID), nil)
+		require.NoError(t, err)
+		rr = httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		dto := orchestration.OperationDetailResponse{}
+		err = json.Unmarshal(rr.Body.Bytes(), &dto)
+		require.NoError(t, err)
+		assert.Equal(t, fixID, dto.OperationID)
+		assert.Equal(t, orchestration.Succeeded, dto.State)
+
+		// given
+		req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/orchestrations/%s", fixID), nil)
+		require.NoError(t, err)
+		rr = httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		status := orchestration.StatusResponse{}
+		err = json.Unmarshal(rr.Body.Bytes(), &status)
+		require.NoError(t, err)
+		assert.Equal(t, 1, status.OperationStats[orchestration.Succeeded])
+	})
+
+	t.Run("retry failed kyma orchestration", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+
+		orchestrationID := "orchestration-" + fixID
+		err := db.Orchestrations().Insert(internal.Orchestration{OrchestrationID: orchestrationID, State: orchestration.Failed, Type: orchestration.UpgradeKymaOrchestration})
+		require.NoError(t, err)
+		for i, state := range []domain.LastOperationState{orchestration.Failed, orchestration.Succeeded} {
+			op := fixture.FixOperation(fmt.Sprintf("id-%d", i), fmt.Sprintf("instance-id-%d", i), internal.OperationTypeUpgradeKyma)
+			op.OrchestrationID = orchestrationID
+			op.State = state
+			err = db.Operations().InsertOperation(op)
+			require.NoError(t, err)
+		}
+
+		logs := logrus.New()
+		kymaQueue := process.NewQueue(&testExecutor{}, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, kymaQueue, 100, logs)
+
+		req, err := http.NewRequest("POST", fmt.Sprintf("/orchestrations/%s/retry", orchestrationID), strings.NewReader("operation-id=id-0&operation-id=id-1"))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kymaHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		var out orchestration.RetryResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"id-1"}, out.InvalidOperations)
+		assert.Len(t, out.RetryShoots, 1)
+
+		o, err := db.Orchestrations().GetByID(orchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Retrying, o.State)
+		assert.Equal(t, []string{"id-0"}, o.Parameters.RetryOperation.RetryOperations)
+	})
+
 	t.Run("cancel orchestration", func(t *testing.T) {
 		// given
 		db := storage.NewMemoryStorage()
@@ -194,7 +313,7 @@ func TestStatusHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 
 		logs := logrus.New()
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, nil, 100, logs)
 
 		req, err := http.NewRequest("PUT", fmt.Sprintf("/orchestrations/%s/cancel", fixID), nil)
 		require.NoError(t, err)
@@ -219,6 +338,53 @@ func TestStatusHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, orchestration.Canceling, o.State)
 	})
+
+	t.Run("pause and resume orchestration", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+
+		err := db.Orchestrations().Insert(internal.Orchestration{OrchestrationID: fixID, State: orchestration.InProgress})
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), nil, nil, 100, logs)
+		router := mux.NewRouter()
+		kymaHandler.AttachRoutes(router)
+
+		for _, tc := range []struct {
+			action        string
+			expectedCode  int
+			expectedState string
+		}{
+			{action: "pause", expectedCode: http.StatusOK, expectedState: orchestration.Paused},
+			{action: "resume", expectedCode: http.StatusOK, expectedState: orchestration.InProgress},
+		} {
+			req, err := http.NewRequest("PUT", fmt.Sprintf("/orchestrations/%s/%s", fixID, tc.action), nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(rr, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rr.Code)
+
+			o, err := db.Orchestrations().GetByID(fixID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, o.State)
+		}
+
+		// when
+		req, err := http.NewRequest("PUT", fmt.Sprintf("/orchestrations/%s/resume", fixID), nil)
+		require.NoError(t, err)
+		err = db.Orchestrations().Update(internal.Orchestration{OrchestrationID: fixID, State: orchestration.Succeeded})
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func TestStatusRetryHandler_AttachRoutes(t *testing.T) {
@@ -248,7 +414,7 @@ func TestStatusRetryHandler_AttachRoutes(t *testing.T) {
 
 		logs := logrus.New()
 		clusterQueue := process.NewQueue(&testExecutor{}, logs)
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, nil, 100, logs)
 
 		for i, id := range operationIDs {
 			operationIDs[i] = "operation-id=" + id
@@ -331,7 +497,7 @@ func TestStatusRetryHandler_AttachRoutes(t *testing.T) {
 
 		logs := logrus.New()
 		clusterQueue := process.NewQueue(&testExecutor{}, logs)
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, nil, 100, logs)
 
 		req, err := http.NewRequest("POST", fmt.Sprintf("/orchestrations/%s/retry", orchestrationID), nil)
 		require.NoError(t, err)
@@ -414,7 +580,7 @@ func TestStatusRetryHandler_AttachRoutes(t *testing.T) {
 
 		logs := logrus.New()
 		clusterQueue := process.NewQueue(&testExecutor{}, logs)
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, nil, 100, logs)
 
 		req, err := http.NewRequest("POST", fmt.Sprintf("/orchestrations/%s/retry", orchestrationID), nil)
 		require.NoError(t, err)
@@ -477,7 +643,7 @@ func TestStatusRetryHandler_AttachRoutes(t *testing.T) {
 
 		logs := logrus.New()
 		clusterQueue := process.NewQueue(&testExecutor{}, logs)
-		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, 100, logs)
+		kymaHandler := NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), clusterQueue, nil, 100, logs)
 
 		req, err := http.NewRequest("POST", fmt.Sprintf("/orchestrations/%s/retry", orchestrationID), nil)
 		require.NoError(t, err)
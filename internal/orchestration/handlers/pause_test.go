@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPauser_PauseForID(t *testing.T) {
+	t.Run("should pause orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		err := s.Orchestrations().Insert(fixOrchestration())
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		o, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Paused, o.State)
+	})
+	t.Run("already paused", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+	})
+	t.Run("should not pause finished orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Succeeded
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+
+		o2, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o2.State)
+	})
+	t.Run("should return error when orchestration not found", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err := p.PauseForID(fixOrchestrationID)
+		assert.Error(t, err)
+	})
+}
+
+func TestPauser_ResumeForID(t *testing.T) {
+	t.Run("should resume orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		o2, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, o2.State)
+	})
+	t.Run("should not resume canceled orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Canceled
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+}
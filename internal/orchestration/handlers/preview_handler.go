@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+// TargetsPreviewer resolves the runtimes targeted by orchestration parameters without persisting anything
+type TargetsPreviewer interface {
+	Preview(params orchestration.Parameters) ([]orchestration.RuntimePreview, error)
+}
+
+type previewHandler struct {
+	previewer TargetsPreviewer
+	log       logrus.FieldLogger
+}
+
+func NewPreviewHandler(previewer TargetsPreviewer, log logrus.FieldLogger) *previewHandler {
+	return &previewHandler{
+		previewer: previewer,
+		log:       log,
+	}
+}
+
+func (h *previewHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/orchestrations/preview", h.previewOrchestration).Methods(http.MethodPost)
+}
+
+func (h *previewHandler) previewOrchestration(w http.ResponseWriter, r *http.Request) {
+	params, ok := decodeAndValidateParameters(w, r, h.log)
+	if !ok {
+		return
+	}
+
+	runtimes, err := h.previewer.Preview(params)
+	if err != nil {
+		h.log.Errorf("while previewing orchestration targets: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while previewing orchestration targets: %w", err))
+		return
+	}
+
+	response := orchestration.PreviewResponse{
+		Data:  runtimes,
+		Count: len(runtimes),
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
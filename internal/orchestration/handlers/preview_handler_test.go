@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewHandler_AttachRoutes(t *testing.T) {
+	t.Run("preview", func(t *testing.T) {
+		// given
+		scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		previewer := &fakePreviewer{runtimes: []orchestration.RuntimePreview{
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-1"}, ScheduledAt: scheduledAt},
+			{Runtime: orchestration.Runtime{RuntimeID: "runtime-id-2"}, ScheduledAt: scheduledAt},
+		}}
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+			},
+			Strategy: orchestration.StrategySpec{
+				Schedule: scheduledAt.Format(time.RFC3339),
+			},
+		}
+
+		// when
+		rr := servePreview(t, previewer, params)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out orchestration.PreviewResponse
+		err := json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		assert.Equal(t, 2, out.Count)
+		assert.Equal(t, "runtime-id-1", out.Data[0].RuntimeID)
+		assert.True(t, scheduledAt.Equal(out.Data[0].ScheduledAt))
+		assert.True(t, scheduledAt.Equal(previewer.params.Strategy.ScheduleTime))
+	})
+
+	t.Run("preview without targets", func(t *testing.T) {
+		// given
+		previewer := &fakePreviewer{}
+
+		// when
+		rr := servePreview(t, previewer, orchestration.Parameters{})
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Nil(t, previewer.params)
+	})
+
+	t.Run("preview failure", func(t *testing.T) {
+		// given
+		previewer := &fakePreviewer{err: fmt.Errorf("gardener failure")}
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+			},
+			Strategy: orchestration.StrategySpec{
+				Schedule: "now",
+			},
+		}
+
+		// when
+		rr := servePreview(t, previewer, params)
+
+		// then
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func servePreview(t *testing.T, previewer TargetsPreviewer, params orchestration.Parameters) *httptest.ResponseRecorder {
+	p, err := json.Marshal(&params)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("POST", "/orchestrations/preview", bytes.NewBuffer(p))
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	router := mux.NewRouter()
+	NewPreviewHandler(previewer, logrus.New()).AttachRoutes(router)
+	router.ServeHTTP(rr, req)
+
+	return rr
+}
+
+type fakePreviewer struct {
+	runtimes []orchestration.RuntimePreview
+	err      error
+	params   *orchestration.Parameters
+}
+
+func (f *fakePreviewer) Preview(params orchestration.Parameters) ([]orchestration.RuntimePreview, error) {
+	f.params = &params
+	return f.runtimes, f.err
+}
@@ -75,7 +75,7 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		m.failOrchestration(o, fmt.Errorf("failed while waiting start for operations: %w", err)) //nolint:errcheck
 	}
 
-	if o.Parameters.Kubernetes == nil || o.Parameters.Kubernetes.KubernetesVersion == "" {
+	if o.Type == orchestration.UpgradeClusterOrchestration && (o.Parameters.Kubernetes == nil || o.Parameters.Kubernetes.KubernetesVersion == "") {
 		o.Parameters.Kubernetes = &orchestration.KubernetesParameters{KubernetesVersion: m.kubernetesVersion}
 	}
 
@@ -100,12 +100,18 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 
 	strategy := m.resolveStrategy(o.Parameters.Strategy.Type, m.executor, logger)
 
+	// operations of the paused orchestration which were not started yet are held until it is resumed
+	var held []orchestration.RuntimeOperation
+	if o.State == orchestration.Paused {
+		operations, held = m.holdPendingOperations(operations, logger)
+	}
+
 	execID, err := strategy.Execute(operations, o.Parameters.Strategy)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute strategy: %w", err)
 	}
 
-	o, err = m.waitForCompletion(o, strategy, execID, logger)
+	o, err = m.waitForCompletion(o, strategy, execID, held, logger)
 	if err != nil && kebError.IsTemporaryError(err) {
 		return 5 * time.Second, nil
 	} else if err != nil {
@@ -124,10 +130,14 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 }
 
 func (m *orchestrationManager) getMaintenancePolicy() (orchestration.MaintenancePolicy, error) {
+	return getMaintenancePolicy(m.k8sClient, m.configNamespace, m.configName)
+}
+
+func getMaintenancePolicy(k8sClient client.Client, configNamespace, configName string) (orchestration.MaintenancePolicy, error) {
 	policy := orchestration.MaintenancePolicy{}
 	config := &coreV1.ConfigMap{}
-	key := client.ObjectKey{Namespace: m.configNamespace, Name: m.configName}
-	if err := m.k8sClient.Get(context.Background(), key, config); err != nil {
+	key := client.ObjectKey{Namespace: configNamespace, Name: configName}
+	if err := k8sClient.Get(context.Background(), key, config); err != nil {
 		return policy, fmt.Errorf("orchestration config is absent")
 	}
 
@@ -183,16 +193,21 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 				continue
 			}
 		}
+		inst, err := m.instanceStorage.GetByID(r.InstanceID)
+		if err != nil {
+			return nil, o, runtimes, fmt.Errorf("while getting instance %s: %w", r.InstanceID, err)
+		}
+
 		if updateWindow {
 			windowBegin := time.Time{}
 			windowEnd := time.Time{}
 			days := []string{}
 
 			if o.State == orchestration.Pending && o.Parameters.Strategy.MaintenanceWindow {
-				windowBegin, windowEnd, days = resolveMaintenanceWindowTime(r, policy, o.Parameters.Strategy.ScheduleTime)
+				windowBegin, windowEnd, days = resolveMaintenanceWindowTime(r, inst.Parameters.Parameters.MaintenanceWindow, policy, o.Parameters.Strategy.ScheduleTime)
 			}
 			if o.State == orchestration.Retrying && bool(o.Parameters.RetryOperation.Immediate) && o.Parameters.Strategy.MaintenanceWindow {
-				windowBegin, windowEnd, days = resolveMaintenanceWindowTime(r, policy, o.Parameters.Strategy.ScheduleTime)
+				windowBegin, windowEnd, days = resolveMaintenanceWindowTime(r, inst.Parameters.Parameters.MaintenanceWindow, policy, o.Parameters.Strategy.ScheduleTime)
 			}
 
 			r.MaintenanceWindowBegin = windowBegin
@@ -206,11 +221,6 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 			}
 		}
 
-		inst, err := m.instanceStorage.GetByID(r.InstanceID)
-		if err != nil {
-			return nil, o, runtimes, fmt.Errorf("while getting instance %s: %w", r.InstanceID, err)
-		}
-
 		op, err = m.factory.NewOperation(*o, r, *inst, orchestration.Pending)
 		if err != nil {
 			return nil, o, runtimes, fmt.Errorf("while creating new operation for runtime id %q: %w", r.RuntimeID, err)
@@ -319,14 +329,66 @@ func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType,
 			s.SpeedUp(m.speedFactor)
 		}
 		return s
+	case orchestration.CanaryStrategy:
+		s := strategies.NewCanaryOrchestrationStrategy(executor, &operationStates{operations: m.operationStorage}, log, 0)
+		if m.speedFactor != 0 {
+			s.SpeedUp(m.speedFactor)
+		}
+		return s
 	}
 	return nil
 }
 
+func pauseStrategy(strategy orchestration.Strategy, execIDs []string, pause bool) {
+	pausable, ok := strategy.(orchestration.PausableStrategy)
+	if !ok {
+		return
+	}
+	for _, id := range execIDs {
+		if pause {
+			pausable.Pause(id)
+		} else {
+			pausable.Resume(id)
+		}
+	}
+}
+
+// operationStates provides states of operations to strategies evaluating results of executed operations
+type operationStates struct {
+	operations storage.Operations
+}
+
+func (s *operationStates) OperationState(operationID string) (string, error) {
+	op, err := s.operations.GetOperationByID(operationID)
+	if err != nil {
+		return "", err
+	}
+	return string(op.State), nil
+}
+
+// holdPendingOperations splits operations into the ones which are already in progress and the pending ones
+func (m *orchestrationManager) holdPendingOperations(operations []orchestration.RuntimeOperation, log logrus.FieldLogger) ([]orchestration.RuntimeOperation, []orchestration.RuntimeOperation) {
+	started := make([]orchestration.RuntimeOperation, 0)
+	held := make([]orchestration.RuntimeOperation, 0)
+	for _, op := range operations {
+		operation, err := m.operationStorage.GetOperationByID(op.ID)
+		if err == nil && operation.State == orchestration.Pending {
+			held = append(held, op)
+			continue
+		}
+		started = append(started, op)
+	}
+	log.Infof("Orchestration is paused, holding %d pending operations", len(held))
+	return started, held
+}
+
 // waitForCompletion waits until processing of given orchestration ends or if it's canceled
-func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, strategy orchestration.Strategy, execID string, log logrus.FieldLogger) (*internal.Orchestration, error) {
+func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, strategy orchestration.Strategy, execID string, held []orchestration.RuntimeOperation, log logrus.FieldLogger) (*internal.Orchestration, error) {
 	orchestrationID := o.OrchestrationID
 	canceled := false
+	// the strategy of the orchestration paused before the restart was not started with the pending operations, so there is nothing to pause
+	paused := o.State == orchestration.Paused
+	var halted error
 	var err error
 	var stats map[string]int
 	execIDs := []string{execID}
@@ -401,8 +463,52 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 			m.log.Infof("PollImmediateInfinite() while resuming %d operations for orchestration %s", len(result), o.OrchestrationID)
 		}
 
-		// don't wait for pending operations if orchestration was canceled
-		if canceled {
+		// hold or release scheduling of operations when the orchestration is paused or resumed
+		if !canceled && halted == nil {
+			if o.State == orchestration.Paused && !paused {
+				log.Info("Orchestration was paused")
+				pauseStrategy(strategy, execIDs, true)
+				paused = true
+			} else if o.State != orchestration.Paused {
+				if paused {
+					log.Info("Orchestration was resumed")
+					pauseStrategy(strategy, execIDs, false)
+					paused = false
+				}
+				if len(held) > 0 {
+					// the halting strategy releases operations in waves, the held operations are not added to the wave being executed
+					_, halting := strategy.(orchestration.HaltingStrategy)
+					if halting || execID == "" || strategy.Insert(execID, held, o.Parameters.Strategy) != nil {
+						resumedExecID, err := strategy.Execute(held, o.Parameters.Strategy)
+						if err != nil {
+							return false, fmt.Errorf("while executing strategy for resumed operations: %w", err)
+						}
+						execIDs = append(execIDs, resumedExecID)
+						execID = resumedExecID
+					}
+					log.Infof("Released %d operations held while the orchestration was paused", len(held))
+					held = nil
+				}
+			}
+		}
+
+		if halting, ok := strategy.(orchestration.HaltingStrategy); ok && halted == nil && !canceled {
+			for _, id := range execIDs {
+				if reason := halting.Halted(id); reason != nil {
+					log.Warnf("Orchestration was halted by the strategy: %s", reason)
+					err := m.factory.CancelOperations(o.OrchestrationID)
+					if err != nil {
+						log.Errorf("while canceling operations of halted orchestration: %v", err)
+						return false, nil
+					}
+					halted = reason
+					break
+				}
+			}
+		}
+
+		// don't wait for pending operations if orchestration was canceled or halted
+		if canceled || halted != nil {
 			return numberOfInProgress == 0, nil
 		} else {
 			return numberOfNotFinished == 0, nil
@@ -412,10 +518,10 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		return nil, fmt.Errorf("while waiting for scheduled operations to finish: %w", err)
 	}
 
-	return m.resolveOrchestration(o, strategy, execIDs, stats)
+	return m.resolveOrchestration(o, strategy, execIDs, stats, halted)
 }
 
-func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execIDs []string, stats map[string]int) (*internal.Orchestration, error) {
+func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execIDs []string, stats map[string]int, halted error) (*internal.Orchestration, error) {
 	if o.State == orchestration.Canceling {
 		err := m.factory.CancelOperations(o.OrchestrationID)
 		if err != nil {
@@ -445,6 +551,9 @@ func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, s
 			}
 		}
 		o.State = orchestration.Canceled
+	} else if halted != nil {
+		o.State = orchestration.Failed
+		o.Description = fmt.Sprintf("Orchestration was halted: %s", halted)
 	} else {
 		state := orchestration.Succeeded
 		if stats[orchestration.Failed] > 0 {
@@ -455,8 +564,52 @@ func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, s
 	return o, nil
 }
 
-// resolves the next exact maintenance window time for the runtime
-func resolveMaintenanceWindowTime(r orchestration.Runtime, policy orchestration.MaintenancePolicy, after time.Time) (time.Time, time.Time, []string) {
+// resolves the next exact maintenance window time for the runtime,
+// the maintenance window chosen for the instance takes precedence over the maintenance policy
+func resolveMaintenanceWindowTime(r orchestration.Runtime, instanceWindow *internal.MaintenanceWindowDTO, policy orchestration.MaintenancePolicy, after time.Time) (time.Time, time.Time, []string) {
+	windowFromInstance := false
+	if instanceWindow != nil {
+		if windowBegin, windowEnd, err := instanceWindow.TimeWindow(); err == nil {
+			r.MaintenanceWindowBegin = windowBegin
+			r.MaintenanceWindowEnd = windowEnd
+			r.MaintenanceDays = instanceWindow.MaintenanceDays()
+			windowFromInstance = true
+		}
+	}
+	if !windowFromInstance {
+		applyMaintenancePolicy(&r, policy)
+	}
+
+	n := time.Now()
+	// If 'after' is in the future, set it as timepoint for the maintenance window calculation
+	if after.After(n) {
+		n = after
+	}
+	availableDays := orchestration.ConvertSliceOfDaysToMap(r.MaintenanceDays)
+	start := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowBegin.Hour(), r.MaintenanceWindowBegin.Minute(), r.MaintenanceWindowBegin.Second(), r.MaintenanceWindowBegin.Nanosecond(), r.MaintenanceWindowBegin.Location())
+	end := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowEnd.Hour(), r.MaintenanceWindowEnd.Minute(), r.MaintenanceWindowEnd.Second(), r.MaintenanceWindowEnd.Nanosecond(), r.MaintenanceWindowEnd.Location())
+	// Set start/end date to the first available day (including today)
+	diff := orchestration.FirstAvailableDayDiff(n.Weekday(), availableDays)
+	start = start.AddDate(0, 0, diff)
+	end = end.AddDate(0, 0, diff)
+
+	// if the window end slips through the next day, adjust the date accordingly
+	if end.Before(start) || end.Equal(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	// if time window has already passed we wait until next available day
+	if start.Before(n) && end.Before(n) {
+		diff := orchestration.NextAvailableDayDiff(n.Weekday(), availableDays)
+		start = start.AddDate(0, 0, diff)
+		end = end.AddDate(0, 0, diff)
+	}
+
+	return start, end, r.MaintenanceDays
+}
+
+// overrides the maintenance window of the runtime with the first matching rule of the policy or with the policy default
+func applyMaintenancePolicy(r *orchestration.Runtime, policy orchestration.MaintenancePolicy) {
 	ruleMatched := false
 
 	for _, p := range policy.Rules {
@@ -515,33 +668,6 @@ func resolveMaintenanceWindowTime(r orchestration.Runtime, policy orchestration.
 			}
 		}
 	}
-
-	n := time.Now()
-	// If 'after' is in the future, set it as timepoint for the maintenance window calculation
-	if after.After(n) {
-		n = after
-	}
-	availableDays := orchestration.ConvertSliceOfDaysToMap(r.MaintenanceDays)
-	start := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowBegin.Hour(), r.MaintenanceWindowBegin.Minute(), r.MaintenanceWindowBegin.Second(), r.MaintenanceWindowBegin.Nanosecond(), r.MaintenanceWindowBegin.Location())
-	end := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowEnd.Hour(), r.MaintenanceWindowEnd.Minute(), r.MaintenanceWindowEnd.Second(), r.MaintenanceWindowEnd.Nanosecond(), r.MaintenanceWindowEnd.Location())
-	// Set start/end date to the first available day (including today)
-	diff := orchestration.FirstAvailableDayDiff(n.Weekday(), availableDays)
-	start = start.AddDate(0, 0, diff)
-	end = end.AddDate(0, 0, diff)
-
-	// if the window end slips through the next day, adjust the date accordingly
-	if end.Before(start) || end.Equal(start) {
-		end = end.AddDate(0, 0, 1)
-	}
-
-	// if time window has already passed we wait until next available day
-	if start.Before(n) && end.Before(n) {
-		diff := orchestration.NextAvailableDayDiff(n.Weekday(), availableDays)
-		start = start.AddDate(0, 0, diff)
-		end = end.AddDate(0, 0, diff)
-	}
-
-	return start, end, r.MaintenanceDays
 }
 
 func (m *orchestrationManager) failOrchestration(o *internal.Orchestration, err error) (time.Duration, error) {
@@ -0,0 +1,65 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveMaintenanceWindowTime(t *testing.T) {
+	policy := orchestration.MaintenancePolicy{
+		Rules: []orchestration.MaintenancePolicyRule{
+			{
+				Match:                  orchestration.MaintenancePolicyMatch{Plan: "azure"},
+				MaintenancePolicyEntry: orchestration.MaintenancePolicyEntry{Days: []string{"Mon"}, TimeBegin: "010000+0000", TimeEnd: "020000+0000"},
+			},
+		},
+	}
+	runtime := orchestration.Runtime{Plan: "azure", MaintenanceDays: []string{"Sun"}}
+	// next Saturday, the maintenance windows are calculated after this time
+	after := time.Now().UTC().AddDate(0, 0, 7+int(time.Saturday-time.Now().UTC().Weekday())).Truncate(24 * time.Hour)
+
+	t.Run("policy rule", func(t *testing.T) {
+		// when
+		begin, end, days := resolveMaintenanceWindowTime(runtime, nil, policy, after)
+
+		// then
+		assert.Equal(t, []string{"Mon"}, days)
+		assert.Equal(t, time.Monday, begin.Weekday())
+		assert.Equal(t, 1, begin.Hour())
+		assert.Equal(t, 2, end.Hour())
+	})
+
+	t.Run("instance maintenance window preferred over policy", func(t *testing.T) {
+		// given
+		window := &internal.MaintenanceWindowDTO{Days: []string{"Wed"}, TimeBegin: "22:30", TimeEnd: "01:00"}
+
+		// when
+		begin, end, days := resolveMaintenanceWindowTime(runtime, window, policy, after)
+
+		// then
+		assert.Equal(t, []string{"Wed"}, days)
+		assert.Equal(t, time.Wednesday, begin.Weekday())
+		assert.Equal(t, 22, begin.Hour())
+		assert.Equal(t, 30, begin.Minute())
+		assert.Equal(t, time.Thursday, end.Weekday())
+		assert.Equal(t, 1, end.Hour())
+		assert.Equal(t, time.UTC, begin.Location())
+	})
+
+	t.Run("instance maintenance window without days", func(t *testing.T) {
+		// given
+		window := &internal.MaintenanceWindowDTO{TimeBegin: "03:00", TimeEnd: "04:00"}
+
+		// when
+		begin, _, days := resolveMaintenanceWindowTime(runtime, window, policy, after)
+
+		// then
+		assert.Len(t, days, 7)
+		assert.Equal(t, time.Saturday, begin.Weekday())
+		assert.Equal(t, 3, begin.Hour())
+	})
+}
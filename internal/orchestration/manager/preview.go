@@ -0,0 +1,77 @@
+package manager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	internalOrchestration "github.com/kyma-project/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Previewer resolves the runtimes targeted by orchestration parameters together with their scheduled start times.
+// Nothing is persisted, so the preview can be used to review the scope of an orchestration before creating it.
+type Previewer struct {
+	instanceStorage storage.Instances
+	resolver        orchestration.RuntimeResolver
+	k8sClient       client.Client
+	configNamespace string
+	configName      string
+	log             logrus.FieldLogger
+}
+
+func NewPreviewer(instanceStorage storage.Instances, resolver orchestration.RuntimeResolver, cli client.Client, cfg internalOrchestration.Config, log logrus.FieldLogger) *Previewer {
+	return &Previewer{
+		instanceStorage: instanceStorage,
+		resolver:        resolver,
+		k8sClient:       cli,
+		configNamespace: cfg.Namespace,
+		configName:      cfg.Name,
+		log:             log,
+	}
+}
+
+// Preview returns the runtimes which an orchestration with the given parameters would target
+func (p *Previewer) Preview(params orchestration.Parameters) ([]orchestration.RuntimePreview, error) {
+	runtimes, err := p.resolver.Resolve(params.Targets)
+	if err != nil {
+		return nil, fmt.Errorf("while resolving targets: %w", err)
+	}
+
+	var policy orchestration.MaintenancePolicy
+	if params.Strategy.MaintenanceWindow {
+		policy, err = getMaintenancePolicy(p.k8sClient, p.configNamespace, p.configName)
+		if err != nil {
+			p.log.Warnf("while getting maintenance policy: %s", err)
+		}
+	}
+
+	now := time.Now()
+	previews := make([]orchestration.RuntimePreview, 0, len(runtimes))
+	for _, r := range runtimes {
+		inst, err := p.instanceStorage.GetByID(r.InstanceID)
+		if err != nil {
+			return nil, fmt.Errorf("while getting instance %s: %w", r.InstanceID, err)
+		}
+		r.Region = inst.ProviderRegion
+
+		scheduledAt := now
+		if params.Strategy.ScheduleTime.After(now) {
+			scheduledAt = params.Strategy.ScheduleTime
+		}
+		if params.Strategy.MaintenanceWindow {
+			r.MaintenanceWindowBegin, r.MaintenanceWindowEnd, r.MaintenanceDays = resolveMaintenanceWindowTime(r, inst.Parameters.Parameters.MaintenanceWindow, policy, params.Strategy.ScheduleTime)
+			scheduledAt = r.MaintenanceWindowBegin
+		}
+
+		previews = append(previews, orchestration.RuntimePreview{
+			Runtime:     r,
+			ScheduledAt: scheduledAt,
+		})
+	}
+
+	return previews, nil
+}
@@ -0,0 +1,126 @@
+package manager_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration/automock"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	internalOrchestration "github.com/kyma-project/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPreviewer_Preview(t *testing.T) {
+	k8sClient := fake.NewFakeClient()
+	orchestrationConfig := internalOrchestration.Config{
+		Namespace: "default",
+		Name:      "policyConfig",
+	}
+	targets := orchestration.TargetSpec{
+		Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+	}
+
+	t.Run("scheduled time", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+		err := store.Instances().Insert(internal.Instance{
+			InstanceID:     "instance-id",
+			RuntimeID:      "runtime-id",
+			ProviderRegion: "westeurope",
+		})
+		require.NoError(t, err)
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		resolver.On("Resolve", targets).Return([]orchestration.Runtime{{
+			InstanceID: "instance-id",
+			RuntimeID:  "runtime-id",
+		}}, nil)
+
+		scheduleTime := time.Now().Add(time.Hour)
+		previewer := manager.NewPreviewer(store.Instances(), resolver, k8sClient, orchestrationConfig, logrus.New())
+
+		// when
+		runtimes, err := previewer.Preview(orchestration.Parameters{
+			Targets:  targets,
+			Strategy: orchestration.StrategySpec{ScheduleTime: scheduleTime},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, runtimes, 1)
+		assert.Equal(t, "runtime-id", runtimes[0].RuntimeID)
+		assert.Equal(t, "westeurope", runtimes[0].Region)
+		assert.Equal(t, scheduleTime, runtimes[0].ScheduledAt)
+
+		orchestrations, count, _, err := store.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, orchestrations)
+		assert.Zero(t, count)
+	})
+
+	t.Run("maintenance window", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+		err := store.Instances().Insert(internal.Instance{
+			InstanceID: "instance-id",
+			RuntimeID:  "runtime-id",
+			Parameters: internal.ProvisioningParameters{
+				Parameters: internal.ProvisioningParametersDTO{
+					MaintenanceWindow: &internal.MaintenanceWindowDTO{Days: []string{"Wed"}, TimeBegin: "22:30", TimeEnd: "01:00"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		resolver.On("Resolve", targets).Return([]orchestration.Runtime{{
+			InstanceID: "instance-id",
+			RuntimeID:  "runtime-id",
+		}}, nil)
+
+		previewer := manager.NewPreviewer(store.Instances(), resolver, k8sClient, orchestrationConfig, logrus.New())
+
+		// when
+		runtimes, err := previewer.Preview(orchestration.Parameters{
+			Targets:  targets,
+			Strategy: orchestration.StrategySpec{MaintenanceWindow: true, ScheduleTime: time.Now()},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, runtimes, 1)
+		assert.Equal(t, time.Wednesday, runtimes[0].ScheduledAt.Weekday())
+		assert.Equal(t, 22, runtimes[0].ScheduledAt.Hour())
+		assert.Equal(t, 30, runtimes[0].ScheduledAt.Minute())
+		assert.Equal(t, runtimes[0].MaintenanceWindowBegin, runtimes[0].ScheduledAt)
+		assert.Equal(t, []string{"Wed"}, runtimes[0].MaintenanceDays)
+	})
+
+	t.Run("resolver failure", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		resolver.On("Resolve", targets).Return(nil, fmt.Errorf("gardener failure"))
+
+		previewer := manager.NewPreviewer(store.Instances(), resolver, k8sClient, orchestrationConfig, logrus.New())
+
+		// when
+		_, err := previewer.Preview(orchestration.Parameters{Targets: targets})
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/orchestration/manager"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -311,6 +312,224 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		assert.Equal(t, orchestration.Canceled, string(op.State))
 	})
 
+	t.Run("Canary halted", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeClusterOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+					Canary:   &orchestration.CanaryStrategySpec{Percentage: 50},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		for _, opID := range []string{"op-1", "op-2"} {
+			err = store.Operations().InsertUpgradeClusterOperation(internal.UpgradeClusterOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(),
+			&retryTestExecutor{store: store, upgradeType: orchestration.UpgradeClusterOrchestration, state: orchestration.Failed},
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, &notificationAutomock.BundleBuilder{}, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.Contains(t, o.Description, "Orchestration was halted")
+
+		stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats[orchestration.Failed])
+		assert.Equal(t, 1, stats[orchestration.Canceled])
+	})
+
+	t.Run("Paused before restart", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Paused,
+			Type:            orchestration.UpgradeClusterOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.ParallelStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		for opID, state := range map[string]string{"in-progress": orchestration.InProgress, "pending": orchestration.Pending} {
+			err = store.Operations().InsertUpgradeClusterOperation(internal.UpgradeClusterOperation{
+				Operation: internal.Operation{
+					ID:               opID,
+					OrchestrationID:  id,
+					State:            domain.LastOperationState(state),
+					RuntimeOperation: orchestration.RuntimeOperation{ID: opID},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(),
+			&retryTestExecutor{store: store, upgradeType: orchestration.UpgradeClusterOrchestration},
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, &notificationAutomock.BundleBuilder{}, 1000)
+
+		// when
+		finished := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			finished <- err
+		}()
+
+		// then
+		assert.Eventually(t, func() bool {
+			op, err := store.Operations().GetUpgradeClusterOperationByID("in-progress")
+			return err == nil && op.State == orchestration.Succeeded
+		}, time.Second, poolingInterval)
+		time.Sleep(5 * poolingInterval)
+		op, err := store.Operations().GetUpgradeClusterOperationByID("pending")
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Pending, string(op.State))
+
+		// when
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		o.State = orchestration.InProgress
+		err = store.Orchestrations().Update(*o)
+		require.NoError(t, err)
+
+		// then
+		require.NoError(t, <-finished)
+		op, err = store.Operations().GetUpgradeClusterOperationByID("pending")
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, string(op.State))
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o.State)
+	})
+
+	t.Run("Canary paused before restart", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Paused,
+			Type:            orchestration.UpgradeClusterOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+					Canary:   &orchestration.CanaryStrategySpec{Percentage: 50, SoakPeriod: "10m"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		pending := []string{"pending-1", "pending-2"}
+		for opID, state := range map[string]string{"in-progress": orchestration.InProgress, pending[0]: orchestration.Pending, pending[1]: orchestration.Pending} {
+			err = store.Operations().InsertUpgradeClusterOperation(internal.UpgradeClusterOperation{
+				Operation: internal.Operation{
+					ID:               opID,
+					OrchestrationID:  id,
+					State:            domain.LastOperationState(state),
+					RuntimeOperation: orchestration.RuntimeOperation{ID: opID},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		// the operation started before the restart is running until the held operations are released
+		release := make(chan struct{})
+		executor := &blockingTestExecutor{
+			retryTestExecutor: retryTestExecutor{store: store, upgradeType: orchestration.UpgradeClusterOrchestration},
+			blockedID:         "in-progress",
+			release:           release,
+		}
+		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(),
+			executor, resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, &notificationAutomock.BundleBuilder{}, 1000)
+
+		succeeded := func() int {
+			count := 0
+			for _, opID := range pending {
+				op, err := store.Operations().GetUpgradeClusterOperationByID(opID)
+				if err == nil && op.State == orchestration.Succeeded {
+					count++
+				}
+			}
+			return count
+		}
+
+		// when
+		finished := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			finished <- err
+		}()
+		time.Sleep(5 * poolingInterval)
+		assert.Zero(t, succeeded())
+
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		o.State = orchestration.InProgress
+		err = store.Orchestrations().Update(*o)
+		require.NoError(t, err)
+
+		// then
+		// the held operations are split into waves, the second wave waits for the soak period of the canary wave
+		assert.Eventually(t, func() bool {
+			return succeeded() == 1
+		}, time.Second, poolingInterval)
+		time.Sleep(5 * poolingInterval)
+		assert.Equal(t, 1, succeeded())
+
+		close(release)
+		require.NoError(t, <-finished)
+		assert.Equal(t, 2, succeeded())
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o.State)
+	})
+
 	t.Run("Retrying failed orchestration", func(t *testing.T) {
 		// given
 		store := storage.NewMemoryStorage()
@@ -597,6 +816,8 @@ func (t *testExecutor) Reschedule(operationID string, maintenanceWindowBegin, ma
 type retryTestExecutor struct {
 	store       storage.BrokerStorage
 	upgradeType orchestration.Type
+	// state is the state the operation ends with, succeeded by default
+	state domain.LastOperationState
 }
 
 func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
@@ -607,8 +828,23 @@ func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
 			return 0, err
 		}
 		op.State = orchestration.Succeeded
+		if t.state != "" {
+			op.State = t.state
+		}
 		_, err = t.store.Operations().UpdateUpgradeClusterOperation(*op)
 
+		return 0, err
+	case orchestration.UpgradeKymaOrchestration:
+		op, err := t.store.Operations().GetOperationByID(opID)
+		if err != nil {
+			return 0, err
+		}
+		op.State = orchestration.Succeeded
+		if t.state != "" {
+			op.State = t.state
+		}
+		_, err = t.store.Operations().UpdateOperation(*op)
+
 		return 0, err
 	}
 
@@ -618,3 +854,17 @@ func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
 func (t *retryTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
 	return nil
 }
+
+// blockingTestExecutor executes the operation with the blocked ID only after the release channel is closed
+type blockingTestExecutor struct {
+	retryTestExecutor
+	blockedID string
+	release   chan struct{}
+}
+
+func (t *blockingTestExecutor) Execute(opID string) (time.Duration, error) {
+	if opID == t.blockedID {
+		<-t.release
+	}
+	return t.retryTestExecutor.Execute(opID)
+}
@@ -0,0 +1,91 @@
+package manager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration/automock"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	notificationAutomock "github.com/kyma-project/kyma-environment-broker/internal/notification/mocks"
+	internalOrchestration "github.com/kyma-project/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpgradeKymaManager_Execute(t *testing.T) {
+	k8sClient := fake.NewFakeClient()
+	orchestrationConfig := internalOrchestration.Config{
+		Namespace: "default",
+		Name:      "policyConfig",
+	}
+
+	t.Run("Pending", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		targets := orchestration.TargetSpec{
+			Include: []orchestration.RuntimeTarget{{RuntimeID: "runtime-id"}},
+		}
+		resolver.On("Resolve", targets).Return([]orchestration.Runtime{{
+			InstanceID: "instance-id",
+			RuntimeID:  "runtime-id",
+		}}, nil)
+
+		err := store.Instances().Insert(internal.Instance{
+			InstanceID: "instance-id",
+			RuntimeID:  "runtime-id",
+		})
+		require.NoError(t, err)
+
+		err = store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Pending,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Targets: targets,
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.ParallelStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+				},
+				Kyma: &orchestration.KymaParameters{Channel: "fast"},
+			},
+		})
+		require.NoError(t, err)
+
+		executor := retryTestExecutor{
+			store:       store,
+			upgradeType: orchestration.UpgradeKymaOrchestration,
+		}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, &notificationAutomock.BundleBuilder{}, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o.State)
+		assert.Nil(t, o.Parameters.Kubernetes)
+
+		ops, _, _, err := store.Operations().ListOperationsByOrchestrationID(id, dbmodel.OperationFilter{})
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		assert.Equal(t, internal.OperationTypeUpgradeKyma, ops[0].Type)
+		assert.Equal(t, orchestration.Succeeded, string(ops[0].State))
+		assert.Equal(t, "instance-id", ops[0].InstanceID)
+	})
+}
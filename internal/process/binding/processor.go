@@ -0,0 +1,149 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	broker "github.com/kyma-project/kyma-environment-broker/internal/broker/bindings"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+const retryInterval = 10 * time.Second
+
+// Processor executes binding operations added to the process.Queue by the bind and unbind endpoints.
+// The queue key is the binding ID, the operation to execute is read from the stored binding.
+type Processor struct {
+	bindings  storage.Bindings
+	instances storage.Instances
+
+	serviceAccountBindingManager broker.BindingsManager
+	gardenerBindingsManager      broker.BindingsManager
+
+	operationTimeout time.Duration
+	log              logrus.FieldLogger
+}
+
+func NewProcessor(bindings storage.Bindings, instances storage.Instances, serviceAccountBindingManager, gardenerBindingsManager broker.BindingsManager, operationTimeout time.Duration, log logrus.FieldLogger) *Processor {
+	return &Processor{
+		bindings:                     bindings,
+		instances:                    instances,
+		serviceAccountBindingManager: serviceAccountBindingManager,
+		gardenerBindingsManager:      gardenerBindingsManager,
+		operationTimeout:             operationTimeout,
+		log:                          log,
+	}
+}
+
+func (p *Processor) Execute(bindingID string) (time.Duration, error) {
+	binding, err := p.bindings.GetByBindingID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		p.log.Infof("binding %s does not exist, nothing to process", bindingID)
+		return 0, nil
+	case err != nil:
+		p.log.Errorf("cannot fetch binding %s from storage: %s", bindingID, err)
+		return 3 * time.Second, nil
+	}
+
+	log := p.log.WithFields(logrus.Fields{"bindingID": binding.ID, "instanceID": binding.InstanceID, "operationType": binding.OperationType})
+	if binding.OperationState != domain.InProgress {
+		log.Infof("binding operation is %s, nothing to process", binding.OperationState)
+		return 0, nil
+	}
+
+	if time.Since(binding.UpdatedAt) > p.operationTimeout {
+		log.Infof("binding operation has reached the time limit: operation was started at: %s", binding.UpdatedAt)
+		return p.failed(binding, "operation has reached the time limit", log)
+	}
+
+	instance, err := p.instances.GetByID(binding.InstanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		if binding.OperationType == internal.BindingOperationTypeUnbind {
+			log.Infof("instance does not exist, removing binding from storage only")
+			return p.deleteFromStorage(binding, log)
+		}
+		return p.failed(binding, fmt.Sprintf("instance %s does not exist", binding.InstanceID), log)
+	case err != nil:
+		log.Errorf("cannot fetch instance from storage: %s", err)
+		return 3 * time.Second, nil
+	}
+
+	switch binding.OperationType {
+	case internal.BindingOperationTypeBind:
+		return p.bind(binding, instance, log)
+	case internal.BindingOperationTypeUnbind:
+		return p.unbind(binding, instance, log)
+	default:
+		return p.failed(binding, fmt.Sprintf("unknown binding operation type %q", binding.OperationType), log)
+	}
+}
+
+func (p *Processor) bind(binding *internal.Binding, instance *internal.Instance, log logrus.FieldLogger) (time.Duration, error) {
+	kubeconfig, err := p.bindingsManager(binding.BindingType).Create(context.Background(), instance, binding)
+	if err != nil {
+		log.Warnf("unable to create kyma binding, retrying in %s: %s", retryInterval, err)
+		return retryInterval, nil
+	}
+
+	now := time.Now()
+	binding.Kubeconfig = kubeconfig
+	binding.ExpiresAt = now.Add(time.Duration(binding.ExpirationSeconds) * time.Second)
+	binding.UpdatedAt = now
+	binding.OperationState = domain.Succeeded
+	binding.OperationDescription = "binding created"
+	err = p.bindings.Update(binding)
+	if err != nil {
+		log.Errorf("unable to save created binding: %s", err)
+		return time.Second, nil
+	}
+
+	log.Infof("binding created")
+	return 0, nil
+}
+
+func (p *Processor) unbind(binding *internal.Binding, instance *internal.Instance, log logrus.FieldLogger) (time.Duration, error) {
+	err := p.bindingsManager(binding.BindingType).Delete(context.Background(), instance, binding)
+	if err != nil {
+		log.Warnf("unable to delete kyma binding, retrying in %s: %s", retryInterval, err)
+		return retryInterval, nil
+	}
+
+	return p.deleteFromStorage(binding, log)
+}
+
+func (p *Processor) deleteFromStorage(binding *internal.Binding, log logrus.FieldLogger) (time.Duration, error) {
+	err := p.bindings.DeleteByBindingID(binding.ID)
+	if err != nil {
+		log.Errorf("unable to delete binding from storage: %s", err)
+		return time.Second, nil
+	}
+
+	log.Infof("binding deleted")
+	return 0, nil
+}
+
+func (p *Processor) failed(binding *internal.Binding, description string, log logrus.FieldLogger) (time.Duration, error) {
+	binding.UpdatedAt = time.Now()
+	binding.OperationState = domain.Failed
+	binding.OperationDescription = description
+	err := p.bindings.Update(binding)
+	if err != nil {
+		log.Errorf("unable to save failed binding operation: %s", err)
+		return time.Second, nil
+	}
+
+	return 0, fmt.Errorf("binding operation failed: %s", description)
+}
+
+func (p *Processor) bindingsManager(bindingType string) broker.BindingsManager {
+	if bindingType == internal.BINDING_TYPE_SERVICE_ACCOUNT {
+		return p.serviceAccountBindingManager
+	}
+	return p.gardenerBindingsManager
+}
@@ -0,0 +1,150 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const instanceID = "instance-id"
+
+func TestProcessor_Bind(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixInProgressBinding("binding-id", internal.BindingOperationTypeBind)
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	manager := &fakeBindingsManager{kubeconfig: "created-kubeconfig"}
+	processor := NewProcessor(st.Bindings(), st.Instances(), manager, manager, time.Minute, logrus.New())
+
+	// when
+	when, err := processor.Execute("binding-id")
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, when)
+
+	stored, err := st.Bindings().GetByBindingID("binding-id")
+	require.NoError(t, err)
+	assert.Equal(t, domain.Succeeded, stored.OperationState)
+	assert.Equal(t, "created-kubeconfig", stored.Kubeconfig)
+	assert.True(t, stored.IsBound())
+}
+
+func TestProcessor_BindRetry(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixInProgressBinding("binding-id", internal.BindingOperationTypeBind)
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	manager := &fakeBindingsManager{err: fmt.Errorf("runtime not reachable")}
+	processor := NewProcessor(st.Bindings(), st.Instances(), manager, manager, time.Minute, logrus.New())
+
+	// when
+	when, err := processor.Execute("binding-id")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, retryInterval, when)
+
+	stored, err := st.Bindings().GetByBindingID("binding-id")
+	require.NoError(t, err)
+	assert.Equal(t, domain.InProgress, stored.OperationState)
+}
+
+func TestProcessor_BindTimeout(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixInProgressBinding("binding-id", internal.BindingOperationTypeBind)
+	binding.UpdatedAt = time.Now().Add(-time.Hour)
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	manager := &fakeBindingsManager{}
+	processor := NewProcessor(st.Bindings(), st.Instances(), manager, manager, time.Minute, logrus.New())
+
+	// when
+	_, err = processor.Execute("binding-id")
+
+	// then
+	assert.Error(t, err)
+
+	stored, err := st.Bindings().GetByBindingID("binding-id")
+	require.NoError(t, err)
+	assert.Equal(t, domain.Failed, stored.OperationState)
+	assert.False(t, manager.created)
+}
+
+func TestProcessor_Unbind(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	err := st.Instances().Insert(fixture.FixInstance(instanceID))
+	require.NoError(t, err)
+	binding := fixInProgressBinding("binding-id", internal.BindingOperationTypeUnbind)
+	err = st.Bindings().Insert(&binding)
+	require.NoError(t, err)
+
+	manager := &fakeBindingsManager{}
+	processor := NewProcessor(st.Bindings(), st.Instances(), manager, manager, time.Minute, logrus.New())
+
+	// when
+	when, err := processor.Execute("binding-id")
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, when)
+	assert.True(t, manager.deleted)
+
+	_, err = st.Bindings().GetByBindingID("binding-id")
+	assert.True(t, dberr.IsNotFound(err))
+}
+
+func fixInProgressBinding(bindingID string, operationType internal.BindingOperationType) internal.Binding {
+	binding := fixture.FixBindingWithInstanceID(bindingID, instanceID)
+	binding.UpdatedAt = time.Now()
+	binding.OperationType = operationType
+	binding.OperationState = domain.InProgress
+	return binding
+}
+
+type fakeBindingsManager struct {
+	kubeconfig string
+	err        error
+
+	created bool
+	deleted bool
+}
+
+func (m *fakeBindingsManager) Create(_ context.Context, _ *internal.Instance, _ *internal.Binding) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.created = true
+	return m.kubeconfig, nil
+}
+
+func (m *fakeBindingsManager) Delete(_ context.Context, _ *internal.Instance, _ *internal.Binding) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.deleted = true
+	return nil
+}
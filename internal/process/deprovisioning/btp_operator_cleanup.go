@@ -91,7 +91,7 @@ func (s *BTPOperatorCleanupStep) Run(operation internal.Operation, log logrus.Fi
 		log.Info("skipping BTP cleanup step for real deprovisioning, not suspension")
 		return operation, 0, nil
 	}
-	if operation.ProvisioningParameters.PlanID != broker.TrialPlanID {
+	if !broker.IsTrialPlan(operation.ProvisioningParameters.PlanID) {
 		log.Info("skipping BTP cleanup step, cleanup executed only for trial plan")
 		return operation, 0, nil
 	}
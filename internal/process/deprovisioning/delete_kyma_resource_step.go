@@ -49,7 +49,7 @@ func (step *DeleteKymaResourceStep) Name() string {
 func (step *DeleteKymaResourceStep) Run(operation internal.Operation, logger logrus.FieldLogger) (internal.Operation, time.Duration, error) {
 	// read the KymaTemplate from the config if needed
 	if operation.KymaTemplate == "" {
-		cfg, err := step.configProvider.ProvideForGivenPlan(broker.PlanNamesMapping[operation.Plan])
+		cfg, err := step.configProvider.ProvideForGivenPlan(broker.PlanNameForID(operation.Plan))
 		if err != nil {
 			return step.operationManager.RetryOperationWithoutFail(operation, step.Name(), "unable to get config for given version and plan", 5*time.Second, 30*time.Second, logger,
 				fmt.Errorf("unable to get config for given version and plan"))
@@ -58,7 +58,7 @@ func (s *RemoveRuntimeStep) Run(operation internal.Operation, log logrus.FieldLo
 		log.Errorf("unable to get instance from storage: %s", err)
 		return operation, 1 * time.Second, nil
 	}
-	if instance.RuntimeID == "" || operation.ProvisioningParameters.PlanID == broker.OwnClusterPlanID {
+	if instance.RuntimeID == "" || broker.IsOwnClusterPlan(operation.ProvisioningParameters.PlanID) {
 		// happens when provisioning process failed and Create_Runtime step was never reached
 		// It can also happen when the SKR is suspended (technically deprovisioned)
 		log.Infof("Runtime does not exist for instance id %q", operation.InstanceID)
@@ -34,17 +34,16 @@ type (
 )
 
 type InputBuilderFactory struct {
-	config                     Config
-	configProvider             ConfigurationProvider
-	trialPlatformRegionMapping map[string]string
-	enabledFreemiumProviders   map[string]struct{}
-	oidcDefaultValues          internal.OIDCConfigDTO
-	useSmallerMachineTypes     bool
+	config                   Config
+	configProvider           ConfigurationProvider
+	reloadableConfig         *ReloadableConfig
+	enabledFreemiumProviders map[string]struct{}
+	useSmallerMachineTypes   bool
 }
 
 func NewInputBuilderFactory(configProvider ConfigurationProvider,
-	config Config, trialPlatformRegionMapping map[string]string,
-	enabledFreemiumProviders []string, oidcValues internal.OIDCConfigDTO, useSmallerMachineTypes bool) (CreatorForPlan, error) {
+	config Config, reloadableConfig *ReloadableConfig,
+	enabledFreemiumProviders []string, useSmallerMachineTypes bool) (*InputBuilderFactory, error) {
 
 	freemiumProviders := map[string]struct{}{}
 	for _, p := range enabledFreemiumProviders {
@@ -52,12 +51,11 @@ func NewInputBuilderFactory(configProvider ConfigurationProvider,
 	}
 
 	return &InputBuilderFactory{
-		config:                     config,
-		configProvider:             configProvider,
-		trialPlatformRegionMapping: trialPlatformRegionMapping,
-		enabledFreemiumProviders:   freemiumProviders,
-		oidcDefaultValues:          oidcValues,
-		useSmallerMachineTypes:     useSmallerMachineTypes,
+		config:                   config,
+		configProvider:           configProvider,
+		reloadableConfig:         reloadableConfig,
+		enabledFreemiumProviders: freemiumProviders,
+		useSmallerMachineTypes:   useSmallerMachineTypes,
 	}, nil
 }
 
@@ -67,7 +65,7 @@ func (f *InputBuilderFactory) SetDefaultTrialProvider(p internal.CloudProvider)
 }
 
 func (f *InputBuilderFactory) IsPlanSupport(planID string) bool {
-	switch planID {
+	switch broker.BasePlanID(planID) {
 	case broker.AWSPlanID, broker.GCPPlanID, broker.AzurePlanID, broker.FreemiumPlanID,
 		broker.AzureLitePlanID, broker.TrialPlanID, broker.SapConvergedCloudPlanID, broker.OwnClusterPlanID, broker.PreviewPlanID:
 		return true
@@ -85,6 +83,17 @@ func (f *InputBuilderFactory) GetPlanDefaults(planID string, platformProvider in
 }
 
 func (f *InputBuilderFactory) getHyperscalerProviderForPlanID(planID string, platformProvider internal.CloudProvider, parametersProvider *internal.CloudProvider) (HyperscalerInputProvider, error) {
+	provider, err := f.getHyperscalerProviderForBasePlanID(broker.BasePlanID(planID), platformProvider, parametersProvider)
+	if err != nil {
+		return nil, err
+	}
+	if definition, found := broker.PlanDefinitionForID(planID); found {
+		return &planDefinitionInput{HyperscalerInputProvider: provider, definition: definition}, nil
+	}
+	return provider, nil
+}
+
+func (f *InputBuilderFactory) getHyperscalerProviderForBasePlanID(planID string, platformProvider internal.CloudProvider, parametersProvider *internal.CloudProvider) (HyperscalerInputProvider, error) {
 	var provider HyperscalerInputProvider
 	switch planID {
 	case broker.GCPPlanID:
@@ -134,7 +143,7 @@ func (f *InputBuilderFactory) CreateProvisionInput(provisioningParameters intern
 		return nil, fmt.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	planName := broker.PlanNameForID(provisioningParameters.PlanID)
 
 	cfg, err := f.configProvider.ProvideForGivenPlan(planName)
 	if err != nil {
@@ -157,7 +166,7 @@ func (f *InputBuilderFactory) CreateProvisionInput(provisioningParameters intern
 		config:                       cfg,
 		hyperscalerInputProvider:     provider,
 		provisioningParameters:       provisioningParameters,
-		oidcDefaultValues:            f.oidcDefaultValues,
+		oidcDefaultValues:            f.reloadableConfig.OIDCDefaultValues(),
 		trialNodesNumber:             f.config.TrialNodesNumber,
 		enableShootAndSeedSameRegion: f.config.EnableShootAndSeedSameRegion,
 	}, nil
@@ -174,16 +183,16 @@ func (f *InputBuilderFactory) forTrialPlan(provider *internal.CloudProvider) Hyp
 	switch trialProvider {
 	case internal.GCP:
 		return &cloudProvider.GcpTrialInput{
-			PlatformRegionMapping: f.trialPlatformRegionMapping,
+			PlatformRegionMapping: f.reloadableConfig.TrialPlatformRegionMapping(),
 		}
 	case internal.AWS:
 		return &cloudProvider.AWSTrialInput{
-			PlatformRegionMapping:  f.trialPlatformRegionMapping,
+			PlatformRegionMapping:  f.reloadableConfig.TrialPlatformRegionMapping(),
 			UseSmallerMachineTypes: f.useSmallerMachineTypes,
 		}
 	default:
 		return &cloudProvider.AzureTrialInput{
-			PlatformRegionMapping:  f.trialPlatformRegionMapping,
+			PlatformRegionMapping:  f.reloadableConfig.TrialPlatformRegionMapping(),
 			UseSmallerMachineTypes: f.useSmallerMachineTypes,
 		}
 	}
@@ -226,7 +235,7 @@ func (f *InputBuilderFactory) CreateUpgradeInput(provisioningParameters internal
 		return nil, fmt.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	planName := broker.PlanNameForID(provisioningParameters.PlanID)
 
 	cfg, err := f.configProvider.ProvideForGivenPlan(planName)
 	if err != nil {
@@ -252,7 +261,7 @@ func (f *InputBuilderFactory) CreateUpgradeInput(provisioningParameters internal
 		provisionRuntimeInput:    kymaInput,
 		upgradeRuntimeInput:      upgradeKymaInput,
 		trialNodesNumber:         f.config.TrialNodesNumber,
-		oidcDefaultValues:        f.oidcDefaultValues,
+		oidcDefaultValues:        f.reloadableConfig.OIDCDefaultValues(),
 		hyperscalerInputProvider: provider,
 		config:                   cfg,
 	}, nil
@@ -273,7 +282,7 @@ func (f *InputBuilderFactory) CreateUpgradeShootInput(provisioningParameters int
 		return nil, fmt.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	planName := broker.PlanNameForID(provisioningParameters.PlanID)
 
 	cfg, err := f.configProvider.ProvideForGivenPlan(planName)
 	if err != nil {
@@ -291,7 +300,7 @@ func (f *InputBuilderFactory) CreateUpgradeShootInput(provisioningParameters int
 		config:                   cfg,
 		hyperscalerInputProvider: provider,
 		trialNodesNumber:         f.config.TrialNodesNumber,
-		oidcDefaultValues:        f.oidcDefaultValues,
+		oidcDefaultValues:        f.reloadableConfig.OIDCDefaultValues(),
 	}, nil
 }
 
@@ -340,3 +349,27 @@ func (f *InputBuilderFactory) IsFreemiumProviderEnabled(provider internal.CloudP
 	_, found := f.enabledFreemiumProviders[strings.ToLower(string(provider))]
 	return found
 }
+
+// planDefinitionInput applies the defaults of a plan loaded from the plans definitions file
+// on top of the defaults of its base plan
+type planDefinitionInput struct {
+	HyperscalerInputProvider
+	definition broker.PlanDefinition
+}
+
+func (p *planDefinitionInput) Defaults() *gqlschema.ClusterConfigInput {
+	defaults := p.HyperscalerInputProvider.Defaults()
+	if defaults.GardenerConfig == nil {
+		return defaults
+	}
+	if p.definition.DefaultMachineType != "" {
+		defaults.GardenerConfig.MachineType = p.definition.DefaultMachineType
+	}
+	if p.definition.DefaultAutoScalerMin > 0 {
+		defaults.GardenerConfig.AutoScalerMin = p.definition.DefaultAutoScalerMin
+	}
+	if p.definition.DefaultAutoScalerMax > 0 {
+		defaults.GardenerConfig.AutoScalerMax = p.definition.DefaultAutoScalerMax
+	}
+	return defaults
+}
@@ -17,7 +17,7 @@ func TestInputBuilderFactory_IsPlanSupport(t *testing.T) {
 	// given
 	configProvider := mockConfigProvider()
 
-	ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+	ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 	assert.NoError(t, err)
 
 	// when/then
@@ -33,7 +33,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		// given
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 
@@ -54,7 +54,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		// given
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 
@@ -73,7 +73,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		// given
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 
@@ -89,7 +89,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		// given
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 
@@ -122,7 +122,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		// given
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 
@@ -160,7 +160,7 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 		var provider HyperscalerInputProvider
 		configProvider := mockConfigProvider()
 
-		ibf, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		ibf, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 		pp := fixProvisioningParameters(broker.GCPPlanID)
 		provider = &cloudProvider.GcpInput{} // for broker.GCPPlanID
@@ -185,6 +185,31 @@ func TestInputBuilderFactory_ForPlan(t *testing.T) {
 
 }
 
+func TestInputBuilderFactory_PlanDefinition(t *testing.T) {
+	// given
+	err := broker.RegisterPlanDefinitions(broker.PlanDefinitions{
+		{ID: "aws-large-id", Name: "aws-large", BasePlan: broker.AWSPlanName, DefaultMachineType: "m6i.2xlarge", DefaultAutoScalerMin: 5, DefaultAutoScalerMax: 30},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, broker.RegisterPlanDefinitions(nil))
+	})
+
+	ibf, err := NewInputBuilderFactory(mockConfigProvider(), Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
+	require.NoError(t, err)
+
+	// when
+	defaults, err := ibf.GetPlanDefaults("aws-large-id", internal.AWS, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, ibf.IsPlanSupport("aws-large-id"))
+	assert.Equal(t, "aws", defaults.GardenerConfig.Provider)
+	assert.Equal(t, "m6i.2xlarge", defaults.GardenerConfig.MachineType)
+	assert.Equal(t, 5, defaults.GardenerConfig.AutoScalerMin)
+	assert.Equal(t, 30, defaults.GardenerConfig.AutoScalerMax)
+}
+
 func fixProvisioningParameters(planID string) internal.ProvisioningParameters {
 	pp := fixture.FixProvisioningParameters("")
 	pp.PlanID = planID
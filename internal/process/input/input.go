@@ -252,7 +252,7 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 	params := r.provisioningParameters.Parameters
 	updateString(&r.provisionRuntimeInput.RuntimeInput.Name, &params.Name)
 
-	if r.provisioningParameters.PlanID == broker.OwnClusterPlanID {
+	if broker.IsOwnClusterPlan(r.provisioningParameters.PlanID) {
 		return nil
 	}
 
@@ -269,6 +269,16 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 		updateString(&r.provisionRuntimeInput.ClusterConfig.GardenerConfig.Region, params.Region)
 	}
 	updateString(&r.provisionRuntimeInput.ClusterConfig.GardenerConfig.MachineType, params.MachineType)
+	if params.KubernetesVersion != nil {
+		// the requested version is pinned, it must not be updated automatically
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.KubernetesVersion = *params.KubernetesVersion
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.EnableKubernetesVersionAutoUpdate = ptr.Bool(false)
+	}
+	if params.MachineImage != nil {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.MachineImage = &params.MachineImage.Name
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.MachineImageVersion = &params.MachineImage.Version
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.EnableMachineImageVersionAutoUpdate = ptr.Bool(false)
+	}
 	updateString(&r.provisionRuntimeInput.ClusterConfig.GardenerConfig.TargetSecret, params.TargetSecret)
 	updateString(r.provisionRuntimeInput.ClusterConfig.GardenerConfig.Purpose, params.Purpose)
 	if params.LicenceType != nil {
@@ -462,8 +472,9 @@ func (r *RuntimeInput) setOIDCForUpgrade() *gqlschema.OIDCConfigInput {
 	return &oidcConfig
 }
 
+// setOIDCFromProvisioningParameters sets the primary OIDC issuer, the provisioner does not support additional issuers
 func (r *RuntimeInput) setOIDCFromProvisioningParameters(oidcConfig *gqlschema.OIDCConfigInput) {
-	providedOIDC := r.provisioningParameters.Parameters.OIDC
+	providedOIDC := r.provisioningParameters.Parameters.OIDC.Issuers()[0]
 	oidcConfig.ClientID = providedOIDC.ClientID
 	oidcConfig.IssuerURL = providedOIDC.IssuerURL
 	if len(providedOIDC.GroupsClaim) != 0 {
@@ -28,8 +28,8 @@ func TestInputBuilderFactoryForAzurePlan(t *testing.T) {
 	}
 	configProvider := mockConfigProvider()
 
-	factory, err := NewInputBuilderFactory(configProvider, config, fixTrialRegionMapping(),
-		fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+	factory, err := NewInputBuilderFactory(configProvider, config, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()),
+		fixTrialProviders(), false)
 	assert.NoError(t, err)
 	pp := fixProvisioningParameters(broker.AzurePlanID)
 
@@ -100,8 +100,8 @@ func TestShouldAdjustRuntimeName(t *testing.T) {
 			// given
 			configProvider := mockConfigProvider()
 
-			builder, err := NewInputBuilderFactory(configProvider, Config{TrialNodesNumber: 0}, fixTrialRegionMapping(),
-				fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			builder, err := NewInputBuilderFactory(configProvider, Config{TrialNodesNumber: 0}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()),
+				fixTrialProviders(), false)
 			assert.NoError(t, err)
 
 			pp := fixProvisioningParameters(broker.TrialPlanID)
@@ -135,7 +135,7 @@ func TestShouldSetNumberOfNodesForTrialPlan(t *testing.T) {
 	configProvider := mockConfigProvider()
 
 	builder, err := NewInputBuilderFactory(configProvider, Config{TrialNodesNumber: 2},
-		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 	assert.NoError(t, err)
 
 	pp := fixProvisioningParameters(broker.TrialPlanID)
@@ -161,7 +161,7 @@ func TestShouldSetGlobalConfiguration(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		builder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		pp := fixProvisioningParameters(broker.TrialPlanID)
@@ -184,7 +184,7 @@ func TestShouldSetGlobalConfiguration(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		builder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		pp := fixProvisioningParameters(broker.TrialPlanID)
@@ -223,8 +223,8 @@ func TestCreateProvisionRuntimeInput_ConfigureDNS(t *testing.T) {
 
 		configProvider := mockConfigProvider()
 
-		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{}, fixTrialRegionMapping(),
-			fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{}, NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()),
+			fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -255,7 +255,7 @@ func TestCreateProvisionRuntimeInput_ConfigureDNS(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -296,7 +296,7 @@ func TestCreateProvisionRuntimeInput_ConfigureOIDC(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -331,7 +331,7 @@ func TestCreateProvisionRuntimeInput_ConfigureOIDC(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -367,7 +367,7 @@ func TestCreateProvisionRuntimeInput_ConfigureOIDC(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -405,7 +405,7 @@ func TestCreateProvisionRuntimeInput_ConfigureAdmins(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -432,7 +432,7 @@ func TestCreateProvisionRuntimeInput_ConfigureAdmins(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -451,6 +451,35 @@ func TestCreateProvisionRuntimeInput_ConfigureAdmins(t *testing.T) {
 	})
 }
 
+func TestCreateProvisionRuntimeInput_KubernetesVersionAndMachineImage(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	inputBuilder, err := NewInputBuilderFactory(mockConfigProvider(), Config{KubernetesVersion: "1.30", MachineImage: "gardenlinux", MachineImageVersion: "1443.3.0", AutoUpdateKubernetesVersion: true, AutoUpdateMachineImageVersion: true},
+		NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.KubernetesVersion = ptr.String("1.29")
+	provisioningParams.Parameters.MachineImage = &internal.MachineImageDTO{Name: "gardenlinux", Version: "1312.3.0"}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams)
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	gardenerConfig := input.ClusterConfig.GardenerConfig
+	assert.Equal(t, "1.29", gardenerConfig.KubernetesVersion)
+	assert.Equal(t, "gardenlinux", *gardenerConfig.MachineImage)
+	assert.Equal(t, "1312.3.0", *gardenerConfig.MachineImageVersion)
+	assert.False(t, *gardenerConfig.EnableKubernetesVersionAutoUpdate)
+	assert.False(t, *gardenerConfig.EnableMachineImageVersionAutoUpdate)
+}
+
 func setRuntimeProperties(creator internal.ProvisionerInputCreator) {
 	creator.SetKubeconfig("example kubeconfig payload")
 	creator.SetRuntimeID("runtimeID")
@@ -470,7 +499,7 @@ func TestCreateUpgradeRuntimeInput_ConfigureAdmins(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -498,7 +527,7 @@ func TestCreateUpgradeRuntimeInput_ConfigureAdmins(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		inputBuilder, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		provisioningParams := fixture.FixProvisioningParameters(id)
@@ -524,7 +553,7 @@ func TestCreateUpgradeShootInput_ConfigureAutoscalerParams(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		ibf, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		//ar provider HyperscalerInputProvider
@@ -556,7 +585,7 @@ func TestCreateUpgradeShootInput_ConfigureAutoscalerParams(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		ibf, err := NewInputBuilderFactory(configProvider, Config{},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		pp := fixProvisioningParameters(broker.GCPPlanID)
@@ -593,7 +622,7 @@ func TestShootAndSeedSameRegion(t *testing.T) {
 		configProvider := mockConfigProvider()
 
 		builder, err := NewInputBuilderFactory(configProvider, Config{EnableShootAndSeedSameRegion: true},
-			fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO(), false)
+			NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixTrialProviders(), false)
 		assert.NoError(t, err)
 
 		pp := fixture.FixProvisioningParameters("")
@@ -0,0 +1,80 @@
+package input
+
+import (
+	"sync"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	cloudProvider "github.com/kyma-project/kyma-environment-broker/internal/provider"
+	"github.com/kyma-project/kyma-environment-broker/internal/runtime"
+)
+
+// ReloadableConfig holds the configuration read from files which can be reloaded while KEB is running.
+// It is shared by the input builders and the steps creating and updating the Runtime resource, so a reload reaches all of them.
+type ReloadableConfig struct {
+	mu                         sync.RWMutex
+	trialPlatformRegionMapping map[string]string
+	oidcDefaultValues          internal.OIDCConfigDTO
+}
+
+func NewReloadableConfig(trialPlatformRegionMapping map[string]string, oidcDefaultValues internal.OIDCConfigDTO) *ReloadableConfig {
+	return &ReloadableConfig{
+		trialPlatformRegionMapping: trialPlatformRegionMapping,
+		oidcDefaultValues:          oidcDefaultValues,
+	}
+}
+
+// TrialPlatformRegionMapping returns the mapping of platform regions to trial regions
+func (c *ReloadableConfig) TrialPlatformRegionMapping() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.trialPlatformRegionMapping
+}
+
+// SetTrialPlatformRegionMapping replaces the mapping of platform regions to trial regions
+func (c *ReloadableConfig) SetTrialPlatformRegionMapping(mapping map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trialPlatformRegionMapping = mapping
+}
+
+// OIDCDefaultValues returns the OIDC configuration used when the provisioning parameters do not contain one
+func (c *ReloadableConfig) OIDCDefaultValues() internal.OIDCConfigDTO {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.oidcDefaultValues
+}
+
+// SetOIDCDefaultValues replaces the OIDC configuration used when the provisioning parameters do not contain one
+func (c *ReloadableConfig) SetOIDCDefaultValues(oidcDefaultValues internal.OIDCConfigDTO) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.oidcDefaultValues = oidcDefaultValues
+}
+
+// ReloadTrialPlatformRegionMapping reads the mapping of platform regions to trial regions from the file,
+// the current mapping stays in use if the file is not valid
+func (c *ReloadableConfig) ReloadTrialPlatformRegionMapping(path string) error {
+	mapping, err := cloudProvider.ReadPlatformRegionMappingFromFile(path)
+	if err != nil {
+		return err
+	}
+	if err := cloudProvider.ValidatePlatformRegionMapping(mapping); err != nil {
+		return err
+	}
+	c.SetTrialPlatformRegionMapping(mapping)
+	return nil
+}
+
+// ReloadOIDCDefaultValues reads the default OIDC configuration from the file,
+// the current configuration stays in use if the file is not valid
+func (c *ReloadableConfig) ReloadOIDCDefaultValues(path string) error {
+	values, err := runtime.ReadOIDCDefaultValuesFromYAML(path)
+	if err != nil {
+		return err
+	}
+	if err := values.Validate(); err != nil {
+		return err
+	}
+	c.SetOIDCDefaultValues(values)
+	return nil
+}
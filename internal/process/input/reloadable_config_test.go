@@ -0,0 +1,71 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadableConfig_ReloadTrialPlatformRegionMapping(t *testing.T) {
+	t.Run("should replace the mapping", func(t *testing.T) {
+		// given
+		config := NewReloadableConfig(map[string]string{"cf-eu10": "europe"}, internal.OIDCConfigDTO{})
+		path := filepath.Join(t.TempDir(), "trial-regions.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("cf-us10: us\n"), 0o600))
+
+		// when
+		err := config.ReloadTrialPlatformRegionMapping(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"cf-us10": "us"}, config.TrialPlatformRegionMapping())
+	})
+
+	t.Run("should keep the mapping if the file is not valid", func(t *testing.T) {
+		// given
+		config := NewReloadableConfig(map[string]string{"cf-eu10": "europe"}, internal.OIDCConfigDTO{})
+		path := filepath.Join(t.TempDir(), "trial-regions.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("cf-us10: mars\n"), 0o600))
+
+		// when
+		err := config.ReloadTrialPlatformRegionMapping(path)
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, map[string]string{"cf-eu10": "europe"}, config.TrialPlatformRegionMapping())
+	})
+}
+
+func TestReloadableConfig_ReloadOIDCDefaultValues(t *testing.T) {
+	t.Run("should replace the OIDC default values", func(t *testing.T) {
+		// given
+		config := NewReloadableConfig(nil, internal.OIDCConfigDTO{ClientID: "client-id"})
+		path := filepath.Join(t.TempDir(), "oidc.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("clientID: new-client-id\nissuerURL: https://issuer.local\nsigningAlgs: [ \"RS256\" ]\n"), 0o600))
+
+		// when
+		err := config.ReloadOIDCDefaultValues(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "new-client-id", config.OIDCDefaultValues().ClientID)
+	})
+
+	t.Run("should keep the OIDC default values if the file is not valid", func(t *testing.T) {
+		// given
+		config := NewReloadableConfig(nil, internal.OIDCConfigDTO{ClientID: "client-id"})
+		path := filepath.Join(t.TempDir(), "oidc.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("clientID: [\n"), 0o600))
+
+		// when
+		err := config.ReloadOIDCDefaultValues(path)
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, "client-id", config.OIDCDefaultValues().ClientID)
+	})
+}
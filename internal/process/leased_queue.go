@@ -0,0 +1,176 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// LeaseConfig configures queues stored in the database and shared by all broker replicas
+type LeaseConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// Duration is the time after which an item claimed by a replica which stopped renewing the lease can be claimed by another replica
+	Duration     time.Duration `envconfig:"default=2m"`
+	PollInterval time.Duration `envconfig:"default=1s"`
+}
+
+// NewLeasedQueue creates a queue which items are stored in the database instead of the memory of the broker replica.
+// An item is processed by the replica which claimed it, the claim (lease) is renewed while the item is processed.
+// Items claimed by a replica which crashed are processed by other replicas when their leases expire.
+func NewLeasedQueue(executor Executor, leases storage.QueueLeases, name, owner string, cfg LeaseConfig, log logrus.FieldLogger) *Queue {
+	return &Queue{
+		queue:     newLeasedWorkQueue(leases, name, owner, cfg, log),
+		executor:  executor,
+		waitGroup: sync.WaitGroup{},
+		log:       log,
+
+		speedFactor: 1,
+	}
+}
+
+// leasedWorkQueue implements the work queue used by the Queue workers on top of the QueueLeases storage
+type leasedWorkQueue struct {
+	leases      storage.QueueLeases
+	name        string
+	owner       string
+	cfg         LeaseConfig
+	rateLimiter workqueue.RateLimiter
+	log         logrus.FieldLogger
+
+	mu      sync.Mutex
+	claimed map[string]claimedItem
+
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+}
+
+type claimedItem struct {
+	lease       internal.QueueLease
+	stopRenewal chan struct{}
+}
+
+func newLeasedWorkQueue(leases storage.QueueLeases, name, owner string, cfg LeaseConfig, log logrus.FieldLogger) *leasedWorkQueue {
+	return &leasedWorkQueue{
+		leases:      leases,
+		name:        name,
+		owner:       owner,
+		cfg:         cfg,
+		rateLimiter: workqueue.DefaultControllerRateLimiter(),
+		log:         log.WithFields(logrus.Fields{"queue": name, "owner": owner}),
+		claimed:     map[string]claimedItem{},
+		shutdown:    make(chan struct{}),
+	}
+}
+
+func (q *leasedWorkQueue) Add(item interface{}) {
+	q.AddAfter(item, 0)
+}
+
+func (q *leasedWorkQueue) AddAfter(item interface{}, duration time.Duration) {
+	if err := q.leases.Add(q.name, item.(string), duration); err != nil {
+		q.log.Errorf("unable to add item %s to the queue: %s", item, err)
+	}
+}
+
+func (q *leasedWorkQueue) AddRateLimited(item interface{}) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+func (q *leasedWorkQueue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+}
+
+func (q *leasedWorkQueue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+func (q *leasedWorkQueue) Len() int {
+	count, err := q.leases.Count(q.name)
+	if err != nil {
+		q.log.Errorf("unable to count items in the queue: %s", err)
+	}
+	return count
+}
+
+// Get blocks until an item is claimed or the queue is shut down
+func (q *leasedWorkQueue) Get() (interface{}, bool) {
+	for {
+		if q.ShuttingDown() {
+			return nil, true
+		}
+		lease, err := q.leases.Claim(q.name, q.owner, q.cfg.Duration)
+		switch {
+		case err == nil:
+			q.startRenewal(lease)
+			return lease.ItemID, false
+		case !dberr.IsNotFound(err):
+			q.log.Errorf("unable to claim an item from the queue: %s", err)
+		}
+
+		select {
+		case <-q.shutdown:
+			return nil, true
+		case <-time.After(q.cfg.PollInterval):
+		}
+	}
+}
+
+// Done removes the processed item from the queue, an item added again while processed (e.g. by AddAfter) is only released
+func (q *leasedWorkQueue) Done(item interface{}) {
+	q.mu.Lock()
+	claimed, found := q.claimed[item.(string)]
+	delete(q.claimed, item.(string))
+	q.mu.Unlock()
+	if !found {
+		return
+	}
+
+	close(claimed.stopRenewal)
+	if err := q.leases.Remove(claimed.lease); err != nil {
+		q.log.Errorf("unable to remove processed item %s from the queue, it is processed again when the lease expires: %s", item, err)
+	}
+}
+
+func (q *leasedWorkQueue) ShutDown() {
+	q.shutdownOnce.Do(func() { close(q.shutdown) })
+}
+
+func (q *leasedWorkQueue) ShutDownWithDrain() {
+	q.ShutDown()
+}
+
+func (q *leasedWorkQueue) ShuttingDown() bool {
+	select {
+	case <-q.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *leasedWorkQueue) startRenewal(lease internal.QueueLease) {
+	stop := make(chan struct{})
+	q.mu.Lock()
+	q.claimed[lease.ItemID] = claimedItem{lease: lease, stopRenewal: stop}
+	q.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(q.cfg.Duration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := q.leases.Renew(lease, q.cfg.Duration); err != nil {
+					q.log.Warnf("unable to renew the lease of item %s, the item can be processed by another replica: %s", lease.ItemID, err)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,122 @@
+package process_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLeaseConfig = process.LeaseConfig{Enabled: true, Duration: 300 * time.Millisecond, PollInterval: 10 * time.Millisecond}
+
+func TestLeasedQueue_ItemsProcessedOnceByAllReplicas(t *testing.T) {
+	// given
+	leases := storage.NewMemoryStorage().QueueLeases()
+	executor := &countingExecutor{executions: map[string]int{}}
+	stop := make(chan struct{})
+	defer close(stop)
+
+	for _, owner := range []string{"replica-1", "replica-2"} {
+		queue := process.NewLeasedQueue(executor, leases, "provisioning", owner, testLeaseConfig, logrus.New())
+		queue.Run(stop, 3)
+		defer queue.ShutDown()
+	}
+	adder := process.NewLeasedQueue(executor, leases, "provisioning", "replica-3", testLeaseConfig, logrus.New())
+
+	// when
+	for i := 0; i < 20; i++ {
+		adder.Add(fmt.Sprintf("op-%d", i))
+	}
+
+	// then
+	assert.Eventually(t, func() bool {
+		count, err := leases.Count("provisioning")
+		return err == nil && count == 0 && executor.total() == 20
+	}, 2*time.Second, 10*time.Millisecond)
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, 1, executor.count(fmt.Sprintf("op-%d", i)))
+	}
+}
+
+func TestLeasedQueue_RetryAfterDuration(t *testing.T) {
+	// given
+	leases := storage.NewMemoryStorage().QueueLeases()
+	executor := &countingExecutor{executions: map[string]int{}, retries: 2}
+	stop := make(chan struct{})
+	defer close(stop)
+
+	queue := process.NewLeasedQueue(executor, leases, "update", "replica-1", testLeaseConfig, logrus.New())
+	queue.Run(stop, 1)
+	defer queue.ShutDown()
+
+	// when
+	queue.Add("op-1")
+
+	// then
+	assert.Eventually(t, func() bool {
+		count, err := leases.Count("update")
+		return err == nil && count == 0 && executor.count("op-1") == 3
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestLeasedQueue_ItemOfStoppedReplicaProcessedWhenLeaseExpires(t *testing.T) {
+	// given
+	leases := storage.NewMemoryStorage().QueueLeases()
+	require.NoError(t, leases.Add("deprovisioning", "op-1", 0))
+	_, err := leases.Claim("deprovisioning", "crashed-replica", 100*time.Millisecond)
+	require.NoError(t, err)
+
+	executor := &countingExecutor{executions: map[string]int{}}
+	stop := make(chan struct{})
+	defer close(stop)
+	queue := process.NewLeasedQueue(executor, leases, "deprovisioning", "replica-1", testLeaseConfig, logrus.New())
+
+	// when
+	queue.Run(stop, 1)
+	defer queue.ShutDown()
+
+	// then
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, executor.count("op-1"))
+	assert.Eventually(t, func() bool {
+		return executor.count("op-1") == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+type countingExecutor struct {
+	mu         sync.Mutex
+	executions map[string]int
+	retries    int
+}
+
+func (e *countingExecutor) Execute(operationID string) (time.Duration, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.executions[operationID]++
+	if e.executions[operationID] <= e.retries {
+		return 10 * time.Millisecond, nil
+	}
+	return 0, nil
+}
+
+func (e *countingExecutor) count(operationID string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.executions[operationID]
+}
+
+func (e *countingExecutor) total() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	total := 0
+	for _, count := range e.executions {
+		total += count
+	}
+	return total
+}
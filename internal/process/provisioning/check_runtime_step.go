@@ -50,8 +50,8 @@ func (s *CheckRuntimeStep) Run(operation internal.Operation, log logrus.FieldLog
 }
 
 func (s *CheckRuntimeStep) checkRuntimeStatus(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if s.kimConfig.IsDrivenByKimOnly(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
-		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+	if s.kimConfig.IsDrivenByKimOnly(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
+		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 		return operation, 0, nil
 	}
 
@@ -169,7 +169,7 @@ func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 		AutoUpdateMachineImageVersion: autoUpdateMachineImageVersion,
 		MultiZoneCluster:              true,
 		ControlPlaneFailureTolerance:  "zone",
-	}, fixTrialRegionMapping(), fixFreemiumProviders(), fixture.FixOIDCConfigDTO(), false)
+	}, input.NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixFreemiumProviders(), false)
 	assert.NoError(t, err)
 
 	pp := internal.ProvisioningParameters{
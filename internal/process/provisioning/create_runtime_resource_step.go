@@ -24,6 +24,7 @@ import (
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
 
 	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
@@ -32,28 +33,26 @@ import (
 )
 
 type CreateRuntimeResourceStep struct {
-	operationManager           *process.OperationManager
-	instanceStorage            storage.Instances
-	runtimeStateStorage        storage.RuntimeStates
-	k8sClient                  client.Client
-	kimConfig                  broker.KimConfig
-	config                     input.Config
-	trialPlatformRegionMapping map[string]string
-	useSmallerMachineTypes     bool
-	oidcDefaultValues          internal.OIDCConfigDTO
+	operationManager       *process.OperationManager
+	instanceStorage        storage.Instances
+	runtimeStateStorage    storage.RuntimeStates
+	k8sClient              client.Client
+	kimConfig              broker.KimConfig
+	config                 input.Config
+	reloadableConfig       *input.ReloadableConfig
+	useSmallerMachineTypes bool
 }
 
 func NewCreateRuntimeResourceStep(os storage.Operations, is storage.Instances, k8sClient client.Client, kimConfig broker.KimConfig, cfg input.Config,
-	trialPlatformRegionMapping map[string]string, useSmallerMachines bool, oidcDefaultValues internal.OIDCConfigDTO) *CreateRuntimeResourceStep {
+	reloadableConfig *input.ReloadableConfig, useSmallerMachines bool) *CreateRuntimeResourceStep {
 	return &CreateRuntimeResourceStep{
-		operationManager:           process.NewOperationManager(os),
-		instanceStorage:            is,
-		kimConfig:                  kimConfig,
-		k8sClient:                  k8sClient,
-		config:                     cfg,
-		trialPlatformRegionMapping: trialPlatformRegionMapping,
-		useSmallerMachineTypes:     useSmallerMachines,
-		oidcDefaultValues:          oidcDefaultValues,
+		operationManager:       process.NewOperationManager(os),
+		instanceStorage:        is,
+		kimConfig:              kimConfig,
+		k8sClient:              k8sClient,
+		config:                 cfg,
+		reloadableConfig:       reloadableConfig,
+		useSmallerMachineTypes: useSmallerMachines,
 	}
 }
 
@@ -67,12 +66,12 @@ func (s *CreateRuntimeResourceStep) Run(operation internal.Operation, log logrus
 		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CreateRuntimeTimeout), nil, log)
 	}
 
-	if !s.kimConfig.IsEnabledForPlan(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
+	if !s.kimConfig.IsEnabledForPlan(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
 		if !s.kimConfig.Enabled {
 			log.Infof("KIM is not enabled, skipping")
 			return operation, 0, nil
 		}
-		log.Infof("KIM is not enabled for plan %s, skipping", broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+		log.Infof("KIM is not enabled for plan %s, skipping", broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 		return operation, 0, nil
 	}
 
@@ -129,7 +128,10 @@ func (s *CreateRuntimeResourceStep) Run(operation internal.Operation, log logrus
 func (s *CreateRuntimeResourceStep) updateRuntimeResourceObject(runtime *imv1.Runtime, operation internal.Operation, runtimeName, kymaName, kymaNamespace string) error {
 
 	// get plan specific values (like zones, default machine type etc.
-	values, err := provider.GenerateValues(&operation, s.config.MultiZoneCluster, s.config.DefaultTrialProvider, s.useSmallerMachineTypes, s.trialPlatformRegionMapping, s.config.DefaultGardenerShootPurpose)
+	// multiple zones enabled in the update request are kept when the runtime is provisioned again
+	multiZone := operation.ProvisioningParameters.Parameters.MultiZone
+	multiZoneCluster := s.config.MultiZoneCluster || (multiZone != nil && *multiZone)
+	values, err := provider.GenerateValues(&operation, multiZoneCluster, s.config.DefaultTrialProvider, s.useSmallerMachineTypes, s.reloadableConfig.TrialPlatformRegionMapping(), s.config.DefaultGardenerShootPurpose)
 	if err != nil {
 		return err
 	}
@@ -165,15 +167,15 @@ func (s *CreateRuntimeResourceStep) createLabelsForRuntime(operation internal.Op
 	labels := map[string]string{
 		"kyma-project.io/instance-id":        operation.InstanceID,
 		"kyma-project.io/runtime-id":         operation.RuntimeID,
-		"kyma-project.io/broker-plan-id":     operation.ProvisioningParameters.PlanID,
-		"kyma-project.io/broker-plan-name":   broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID],
+		k8s.PlanIdLabel:                      operation.ProvisioningParameters.PlanID,
+		k8s.PlanNameLabel:                    broker.PlanNameForID(operation.ProvisioningParameters.PlanID),
 		"kyma-project.io/global-account-id":  operation.ProvisioningParameters.ErsContext.GlobalAccountID,
 		"kyma-project.io/subaccount-id":      operation.ProvisioningParameters.ErsContext.SubAccountID,
 		"kyma-project.io/shoot-name":         operation.ShootName,
 		"kyma-project.io/region":             region,
 		"operator.kyma-project.io/kyma-name": kymaName,
 	}
-	controlledByProvisioner := s.kimConfig.ViewOnly && !s.kimConfig.IsDrivenByKimOnly(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+	controlledByProvisioner := s.kimConfig.ViewOnly && !s.kimConfig.IsDrivenByKimOnly(broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 	labels[imv1.LabelControlledByProvisioner] = strconv.FormatBool(controlledByProvisioner)
 	return labels
 }
@@ -208,13 +210,10 @@ func (s *CreateRuntimeResourceStep) createShootProvider(operation *internal.Oper
 		Type: values.ProviderType,
 		Workers: []gardener.Worker{
 			{
-				Name: "cpu-worker-0",
+				Name: internal.KymaWorkerPoolName,
 				Machine: gardener.Machine{
-					Type: DefaultIfParamNotSet(values.DefaultMachineType, operation.ProvisioningParameters.Parameters.MachineType),
-					Image: &gardener.ShootMachineImage{
-						Name:    s.config.MachineImage,
-						Version: &s.config.MachineImageVersion,
-					},
+					Type:  DefaultIfParamNotSet(values.DefaultMachineType, operation.ProvisioningParameters.Parameters.MachineType),
+					Image: s.createMachineImage(operation),
 				},
 				Maximum:        scalerMax,
 				Minimum:        scalerMin,
@@ -232,9 +231,50 @@ func (s *CreateRuntimeResourceStep) createShootProvider(operation *internal.Oper
 			VolumeSize: fmt.Sprintf("%sGi", volumeSize),
 		}
 	}
+	provider.Workers = append(provider.Workers, AdditionalWorkers(operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools, provider.Workers[0], nil)...)
 	return provider, nil
 }
 
+// AdditionalWorkers creates workers for the additional worker node pools based on the Kyma worker, which provides the machine image and the volume.
+// HA pools are spread across all zones of the Kyma worker, other pools use a single zone. Zones of the already existing workers are not changed.
+func AdditionalWorkers(pools []internal.AdditionalWorkerNodePool, kymaWorker gardener.Worker, existing []gardener.Worker) []gardener.Worker {
+	existingZones := make(map[string][]string, len(existing))
+	for _, worker := range existing {
+		existingZones[worker.Name] = worker.Zones
+	}
+
+	workers := make([]gardener.Worker, 0, len(pools))
+	for _, pool := range pools {
+		zones, found := existingZones[pool.Name]
+		if !found {
+			zones = kymaWorker.Zones
+			if !pool.HAZones && len(zones) > 1 {
+				zones = zones[:1]
+			}
+		}
+		maxSurge := intstr.FromInt32(int32(len(zones)))
+		maxUnavailable := intstr.FromInt32(0)
+		worker := gardener.Worker{
+			Name: pool.Name,
+			Machine: gardener.Machine{
+				Type:  pool.MachineType,
+				Image: kymaWorker.Machine.Image,
+			},
+			Maximum:        int32(pool.AutoScalerMax),
+			Minimum:        int32(pool.AutoScalerMin),
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+			Zones:          append([]string{}, zones...),
+		}
+		if kymaWorker.Volume != nil {
+			volume := *kymaWorker.Volume
+			worker.Volume = &volume
+		}
+		workers = append(workers, worker)
+	}
+	return workers
+}
+
 func (s *CreateRuntimeResourceStep) createHighAvailabilityConfiguration() *gardener.HighAvailability {
 
 	failureToleranceType := gardener.FailureToleranceTypeZone
@@ -283,41 +323,27 @@ func (s *CreateRuntimeResourceStep) getEmptyOrExistingRuntimeResource(name, name
 	return &runtime, nil
 }
 
-func (s *CreateRuntimeResourceStep) createKubernetesConfiguration(operation internal.Operation) imv1.Kubernetes {
-	oidc := gardener.OIDCConfig{
-		ClientID:       &s.oidcDefaultValues.ClientID,
-		GroupsClaim:    &s.oidcDefaultValues.GroupsClaim,
-		IssuerURL:      &s.oidcDefaultValues.IssuerURL,
-		SigningAlgs:    s.oidcDefaultValues.SigningAlgs,
-		UsernameClaim:  &s.oidcDefaultValues.UsernameClaim,
-		UsernamePrefix: &s.oidcDefaultValues.UsernamePrefix,
-	}
-	if operation.ProvisioningParameters.Parameters.OIDC != nil {
-		if operation.ProvisioningParameters.Parameters.OIDC.ClientID != "" {
-			oidc.ClientID = &operation.ProvisioningParameters.Parameters.OIDC.ClientID
-		}
-		if operation.ProvisioningParameters.Parameters.OIDC.GroupsClaim != "" {
-			oidc.GroupsClaim = &operation.ProvisioningParameters.Parameters.OIDC.GroupsClaim
-		}
-		if operation.ProvisioningParameters.Parameters.OIDC.IssuerURL != "" {
-			oidc.IssuerURL = &operation.ProvisioningParameters.Parameters.OIDC.IssuerURL
-		}
-		if len(operation.ProvisioningParameters.Parameters.OIDC.SigningAlgs) > 0 {
-			oidc.SigningAlgs = operation.ProvisioningParameters.Parameters.OIDC.SigningAlgs
-		}
-		if operation.ProvisioningParameters.Parameters.OIDC.UsernameClaim != "" {
-			oidc.UsernameClaim = &operation.ProvisioningParameters.Parameters.OIDC.UsernameClaim
-		}
-		if operation.ProvisioningParameters.Parameters.OIDC.UsernamePrefix != "" {
-			oidc.UsernamePrefix = &operation.ProvisioningParameters.Parameters.OIDC.UsernamePrefix
+func (s *CreateRuntimeResourceStep) createMachineImage(operation *internal.Operation) *gardener.ShootMachineImage {
+	if image := operation.ProvisioningParameters.Parameters.MachineImage; image != nil {
+		return &gardener.ShootMachineImage{
+			Name:    image.Name,
+			Version: ptr.String(image.Version),
 		}
 	}
+	return &gardener.ShootMachineImage{
+		Name:    s.config.MachineImage,
+		Version: &s.config.MachineImageVersion,
+	}
+}
+
+func (s *CreateRuntimeResourceStep) createKubernetesConfiguration(operation internal.Operation) imv1.Kubernetes {
+	oidc, additionalOidc := steps.OIDCConfigs(operation.ProvisioningParameters.Parameters.OIDC, s.reloadableConfig.OIDCDefaultValues())
 
 	return imv1.Kubernetes{
-		Version: ptr.String(s.config.KubernetesVersion),
+		Version: ptr.String(DefaultIfParamNotSet(s.config.KubernetesVersion, operation.ProvisioningParameters.Parameters.KubernetesVersion)),
 		KubeAPIServer: imv1.APIServer{
 			OidcConfig:           oidc,
-			AdditionalOidcConfig: nil,
+			AdditionalOidcConfig: additionalOidc,
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -32,6 +33,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"k8s.io/client-go/kubernetes/scheme"
 )
@@ -79,7 +81,7 @@ func TestCreateRuntimeResourceStep_OIDC_AllCustom(t *testing.T) {
 	kimConfig := fixKimConfig("azure", false)
 	inputConfig := input.Config{MultiZoneCluster: true}
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -121,7 +123,7 @@ func TestCreateRuntimeResourceStep_OIDC_MixedCustom(t *testing.T) {
 	kimConfig := fixKimConfig("azure", false)
 	inputConfig := input.Config{MultiZoneCluster: true}
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -146,6 +148,52 @@ func TestCreateRuntimeResourceStep_OIDC_MixedCustom(t *testing.T) {
 	}, runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig)
 }
 
+func TestCreateRuntimeResourceStep_ReloadedConfig(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	log := logrus.New()
+	memoryStorage := storage.NewMemoryStorage()
+	instance, operation := fixInstanceAndOperation(broker.TrialPlanID, "", "cf-us10")
+	assertInsertions(t, memoryStorage, instance, operation)
+	kimConfig := fixKimConfig("trial", false)
+	inputConfig := input.Config{DefaultTrialProvider: internal.AWS}
+	cli := getClientForTests(t)
+	reloadableConfig := input.NewReloadableConfig(map[string]string{"cf-us10": "europe"}, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, reloadableConfig, false)
+
+	dir := t.TempDir()
+	regionMappingPath := filepath.Join(dir, "trial-regions.yaml")
+	require.NoError(t, os.WriteFile(regionMappingPath, []byte("cf-us10: us\n"), 0o600))
+	oidcPath := filepath.Join(dir, "oidc.yaml")
+	require.NoError(t, os.WriteFile(oidcPath, []byte(`clientID: "client-id-reloaded"
+issuerURL: "https://issuer.reloaded"
+groupsClaim: "groups"
+signingAlgs: [ "RS256" ]
+usernamePrefix: "-"
+usernameClaim: "sub"
+`), 0o600))
+
+	// when
+	require.NoError(t, reloadableConfig.ReloadTrialPlatformRegionMapping(regionMappingPath))
+	require.NoError(t, reloadableConfig.ReloadOIDCDefaultValues(oidcPath))
+	entry := log.WithFields(logrus.Fields{"step": "TEST"})
+	_, repeat, err := step.Run(operation, entry)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, repeat)
+	runtime := imv1.Runtime{}
+	err = cli.Get(context.Background(), client.ObjectKey{
+		Namespace: "kyma-system",
+		Name:      operation.RuntimeID,
+	}, &runtime)
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east-1", runtime.Spec.Shoot.Region)
+	assert.Equal(t, "client-id-reloaded", *runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID)
+	assert.Equal(t, "https://issuer.reloaded", *runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL)
+}
+
 func TestCreateRuntimeResourceStep_Defaults_Azure_MultiZone_YamlOnly(t *testing.T) {
 	// given
 	log := logrus.New()
@@ -157,7 +205,7 @@ func TestCreateRuntimeResourceStep_Defaults_Azure_MultiZone_YamlOnly(t *testing.
 	kimConfig := fixKimConfig("azure", true)
 	inputConfig := input.Config{MultiZoneCluster: true}
 
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), nil, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), nil, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -197,7 +245,7 @@ func TestCreateRuntimeResourceStep_AllYamls(t *testing.T) {
 			kimConfig := fixKimConfigWithAllPlans(true)
 			inputConfig := input.Config{MultiZoneCluster: testCase.multiZone}
 
-			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), nil, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), nil, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 			// when
 			entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -227,7 +275,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_SingleZone_EnforceSeed_ActualCre
 	inputConfig := input.Config{MultiZoneCluster: false, ControlPlaneFailureTolerance: "zone", DefaultGardenerShootPurpose: provider.PurposeProduction}
 
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -277,7 +325,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_SingleZone_DisableEnterpriseFilt
 	inputConfig := input.Config{MultiZoneCluster: false, ControlPlaneFailureTolerance: "zone", DefaultGardenerShootPurpose: provider.PurposeProduction}
 
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -327,7 +375,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_SingleZone_DefaultAdmin_ActualCr
 	inputConfig := input.Config{MultiZoneCluster: false, ControlPlaneFailureTolerance: "zone", DefaultGardenerShootPurpose: provider.PurposeProduction}
 
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -376,7 +424,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_SingleZone_DryRun_ActualCreation
 	inputConfig := input.Config{MultiZoneCluster: false, ControlPlaneFailureTolerance: "zone", DefaultGardenerShootPurpose: provider.PurposeProduction}
 
 	cli := getClientForTests(t)
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -430,7 +478,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_MultiZoneWithNetworking_ActualCr
 
 	cli := getClientForTests(t)
 	inputConfig := input.Config{MultiZoneCluster: true, DefaultGardenerShootPurpose: provider.PurposeProduction}
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -482,7 +530,7 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_MultiZone_ActualCreation(t *test
 
 	cli := getClientForTests(t)
 	inputConfig := input.Config{MultiZoneCluster: true, DefaultGardenerShootPurpose: provider.PurposeProduction}
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -513,6 +561,101 @@ func TestCreateRuntimeResourceStep_Defaults_AWS_MultiZone_ActualCreation(t *test
 	assert.NoError(t, err)
 }
 
+func TestCreateRuntimeResourceStep_AdditionalWorkerNodePools(t *testing.T) {
+	// given
+	log := logrus.New()
+	memoryStorage := storage.NewMemoryStorage()
+
+	err := imv1.AddToScheme(scheme.Scheme)
+
+	instance, operation := fixInstanceAndOperation(broker.AWSPlanID, "eu-west-2", "platform-region")
+	operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools = []internal.AdditionalWorkerNodePool{
+		{Name: "name-1", MachineType: "m6i.xlarge", HAZones: true, AutoScalerMin: 3, AutoScalerMax: 20},
+		{Name: "name-2", MachineType: "m5.large", HAZones: false, AutoScalerMin: 0, AutoScalerMax: 5},
+	}
+	assertInsertions(t, memoryStorage, instance, operation)
+
+	kimConfig := fixKimConfig("aws", false)
+
+	cli := getClientForTests(t)
+	inputConfig := input.Config{MultiZoneCluster: true, DefaultGardenerShootPurpose: provider.PurposeProduction}
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
+
+	// when
+	entry := log.WithFields(logrus.Fields{"step": "TEST"})
+	_, repeat, err := step.Run(operation, entry)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, repeat)
+
+	runtime := imv1.Runtime{}
+	err = cli.Get(context.Background(), client.ObjectKey{
+		Namespace: "kyma-system",
+		Name:      operation.RuntimeID,
+	}, &runtime)
+	require.NoError(t, err)
+
+	workers := runtime.Spec.Shoot.Provider.Workers
+	require.Len(t, workers, 3)
+	assert.Equal(t, internal.KymaWorkerPoolName, workers[0].Name)
+
+	assert.Equal(t, "name-1", workers[1].Name)
+	assert.Equal(t, "m6i.xlarge", workers[1].Machine.Type)
+	assert.Equal(t, workers[0].Machine.Image, workers[1].Machine.Image)
+	assert.Equal(t, workers[0].Volume, workers[1].Volume)
+	assert.Equal(t, int32(3), workers[1].Minimum)
+	assert.Equal(t, int32(20), workers[1].Maximum)
+	assert.ElementsMatch(t, workers[0].Zones, workers[1].Zones)
+	assert.Equal(t, 3, workers[1].MaxSurge.IntValue())
+
+	assert.Equal(t, "name-2", workers[2].Name)
+	assert.Equal(t, "m5.large", workers[2].Machine.Type)
+	assert.Equal(t, int32(0), workers[2].Minimum)
+	assert.Equal(t, int32(5), workers[2].Maximum)
+	assert.Len(t, workers[2].Zones, 1)
+	assert.Subset(t, workers[0].Zones, workers[2].Zones)
+	assert.Equal(t, 1, workers[2].MaxSurge.IntValue())
+}
+
+func TestCreateRuntimeResourceStep_KubernetesVersionAndMachineImage(t *testing.T) {
+	// given
+	log := logrus.New()
+	memoryStorage := storage.NewMemoryStorage()
+
+	err := imv1.AddToScheme(scheme.Scheme)
+
+	instance, operation := fixInstanceAndOperation(broker.AWSPlanID, "eu-west-2", "platform-region")
+	operation.ProvisioningParameters.Parameters.KubernetesVersion = ptr.String("1.29")
+	operation.ProvisioningParameters.Parameters.MachineImage = &internal.MachineImageDTO{Name: "gardenlinux", Version: "1312.3.0"}
+	assertInsertions(t, memoryStorage, instance, operation)
+
+	kimConfig := fixKimConfig("aws", false)
+
+	cli := getClientForTests(t)
+	inputConfig := input.Config{KubernetesVersion: "1.30", MachineImage: "gardenlinux", MachineImageVersion: "1443.3.0", DefaultGardenerShootPurpose: provider.PurposeProduction}
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
+
+	// when
+	entry := log.WithFields(logrus.Fields{"step": "TEST"})
+	_, repeat, err := step.Run(operation, entry)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, repeat)
+
+	runtime := imv1.Runtime{}
+	err = cli.Get(context.Background(), client.ObjectKey{
+		Namespace: "kyma-system",
+		Name:      operation.RuntimeID,
+	}, &runtime)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.29", *runtime.Spec.Shoot.Kubernetes.Version)
+	assert.Equal(t, "gardenlinux", runtime.Spec.Shoot.Provider.Workers[0].Machine.Image.Name)
+	assert.Equal(t, "1312.3.0", *runtime.Spec.Shoot.Provider.Workers[0].Machine.Image.Version)
+}
+
 func TestCreateRuntimeResourceStep_Defaults_Preview_SingleZone_ActualCreation(t *testing.T) {
 	// given
 	log := logrus.New()
@@ -527,7 +670,7 @@ func TestCreateRuntimeResourceStep_Defaults_Preview_SingleZone_ActualCreation(t
 
 	cli := getClientForTests(t)
 	inputConfig := input.Config{MultiZoneCluster: false, DefaultGardenerShootPurpose: provider.PurposeProduction}
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -573,7 +716,7 @@ func TestCreateRuntimeResourceStep_Defaults_Preview_SingleZone_ActualCreation_Wi
 
 	cli := getClientForTests(t)
 	inputConfig := input.Config{MultiZoneCluster: false, DefaultGardenerShootPurpose: provider.PurposeProduction}
-	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+	step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -651,7 +794,7 @@ func TestCreateRuntimeResourceStep_SapConvergedCloud(t *testing.T) {
 
 			cli := getClientForTests(t)
 			inputConfig := input.Config{MultiZoneCluster: testCase.expectedZonesCount > 1}
-			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 			// when
 			entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -703,7 +846,7 @@ func TestCreateRuntimeResourceStep_Defaults_Freemium(t *testing.T) {
 
 			cli := getClientForTests(t)
 			inputConfig := input.Config{MultiZoneCluster: true}
-			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, nil, false, defaultOIDSConfig)
+			step := NewCreateRuntimeResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), cli, kimConfig, inputConfig, input.NewReloadableConfig(nil, defaultOIDSConfig), false)
 
 			// when
 			entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -786,7 +929,7 @@ func assertLabels(t *testing.T, operation internal.Operation, runtime imv1.Runti
 	assert.Equal(t, operation.InstanceID, runtime.Labels["kyma-project.io/instance-id"])
 	assert.Equal(t, operation.RuntimeID, runtime.Labels["kyma-project.io/runtime-id"])
 	assert.Equal(t, operation.ProvisioningParameters.PlanID, runtime.Labels["kyma-project.io/broker-plan-id"])
-	assert.Equal(t, broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID], runtime.Labels["kyma-project.io/broker-plan-name"])
+	assert.Equal(t, broker.PlanNameForID(operation.ProvisioningParameters.PlanID), runtime.Labels["kyma-project.io/broker-plan-name"])
 	assert.Equal(t, operation.ProvisioningParameters.ErsContext.GlobalAccountID, runtime.Labels["kyma-project.io/global-account-id"])
 	assert.Equal(t, operation.ProvisioningParameters.ErsContext.SubAccountID, runtime.Labels["kyma-project.io/subaccount-id"])
 	assert.Equal(t, operation.ShootName, runtime.Labels["kyma-project.io/shoot-name"])
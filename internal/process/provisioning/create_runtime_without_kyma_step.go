@@ -46,8 +46,8 @@ func (s *CreateRuntimeWithoutKymaStep) Name() string {
 }
 
 func (s *CreateRuntimeWithoutKymaStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if s.kimConfig.IsDrivenByKimOnly(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
-		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+	if s.kimConfig.IsDrivenByKimOnly(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
+		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 		return operation, 0, nil
 	}
 
@@ -50,8 +50,8 @@ func (_ *checkGardenerCluster) Name() string {
 }
 
 func (s *checkGardenerCluster) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if s.kimConfig.IsDrivenByKim(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
-		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+	if s.kimConfig.IsDrivenByKim(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
+		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 		return operation, 0, nil
 	}
 
@@ -103,8 +103,8 @@ func (_ *syncGardenerCluster) Name() string {
 }
 
 func (s *syncGardenerCluster) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if s.kimConfig.IsDrivenByKim(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
-		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID])
+	if s.kimConfig.IsDrivenByKim(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
+		log.Infof("KIM is driving the process for plan %s, skipping", broker.PlanNameForID(operation.ProvisioningParameters.PlanID))
 		return operation, 0, nil
 	}
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
 
 	"github.com/kyma-project/kyma-environment-broker/internal"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,8 +19,8 @@ func ApplyLabelsAndAnnotationsForLM(object client.Object, operation internal.Ope
 	}
 	l["kyma-project.io/instance-id"] = operation.InstanceID
 	l["kyma-project.io/runtime-id"] = operation.RuntimeID
-	l["kyma-project.io/broker-plan-id"] = operation.ProvisioningParameters.PlanID
-	l["kyma-project.io/broker-plan-name"] = broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]
+	l[k8s.PlanIdLabel] = operation.ProvisioningParameters.PlanID
+	l[k8s.PlanNameLabel] = broker.PlanNameForID(operation.ProvisioningParameters.PlanID)
 	l["kyma-project.io/global-account-id"] = operation.GlobalAccountID
 	l["kyma-project.io/subaccount-id"] = operation.SubAccountID
 	l["kyma-project.io/shoot-name"] = operation.ShootName
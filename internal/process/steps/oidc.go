@@ -0,0 +1,58 @@
+package steps
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+)
+
+// OIDCConfigs converts the OIDC parameters into the OIDC configuration of the Runtime resource.
+// The first issuer is returned as the primary configuration, others as additional configurations (nil if there are none).
+// Values not provided for an issuer are taken from the default values.
+func OIDCConfigs(params *internal.OIDCConfigDTO, defaults internal.OIDCConfigDTO) (gardener.OIDCConfig, *[]gardener.OIDCConfig) {
+	issuers := params.Issuers()
+	if len(issuers) == 0 {
+		return OIDCConfig(internal.OIDCConfigDTO{}, defaults), nil
+	}
+
+	primary := OIDCConfig(issuers[0], defaults)
+	if len(issuers) == 1 {
+		return primary, nil
+	}
+	additional := make([]gardener.OIDCConfig, 0, len(issuers)-1)
+	for _, issuer := range issuers[1:] {
+		additional = append(additional, OIDCConfig(issuer, defaults))
+	}
+	return primary, &additional
+}
+
+// OIDCConfig converts the configuration of a single OIDC issuer, values not provided are taken from the default values
+func OIDCConfig(issuer, defaults internal.OIDCConfigDTO) gardener.OIDCConfig {
+	value := func(provided, defaultValue string) *string {
+		if provided != "" {
+			return &provided
+		}
+		return &defaultValue
+	}
+
+	oidc := gardener.OIDCConfig{
+		ClientID:       value(issuer.ClientID, defaults.ClientID),
+		GroupsClaim:    value(issuer.GroupsClaim, defaults.GroupsClaim),
+		IssuerURL:      value(issuer.IssuerURL, defaults.IssuerURL),
+		SigningAlgs:    defaults.SigningAlgs,
+		UsernameClaim:  value(issuer.UsernameClaim, defaults.UsernameClaim),
+		UsernamePrefix: value(issuer.UsernamePrefix, defaults.UsernamePrefix),
+	}
+	if len(issuer.SigningAlgs) > 0 {
+		oidc.SigningAlgs = issuer.SigningAlgs
+	}
+	if issuer.GroupsPrefix != "" {
+		oidc.GroupsPrefix = &issuer.GroupsPrefix
+	} else if defaults.GroupsPrefix != "" {
+		oidc.GroupsPrefix = &defaults.GroupsPrefix
+	}
+	// required claims are validated by the broker
+	if claims, err := issuer.RequiredClaimsMap(); err == nil && len(claims) > 0 {
+		oidc.RequiredClaims = claims
+	}
+	return oidc
+}
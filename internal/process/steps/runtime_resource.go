@@ -36,7 +36,7 @@ func (_ *checkRuntimeResource) Name() string {
 }
 
 func (s *checkRuntimeResource) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if !s.kimConfig.IsDrivenByKim(broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]) {
+	if !s.kimConfig.IsDrivenByKim(broker.PlanNameForID(operation.ProvisioningParameters.PlanID)) {
 		log.Infof("Only provisioner is controlling provisioning process, skipping")
 		return operation, 0, nil
 	}
@@ -12,3 +12,11 @@ func ForBTPOperatorCredentialsProvided(op internal.Operation) bool {
 func SkipForOwnClusterPlan(op internal.Operation) bool {
 	return !broker.IsOwnClusterPlan(op.ProvisioningParameters.PlanID)
 }
+
+func ForPlanChange(op internal.Operation) bool {
+	return op.PreviousPlanID != ""
+}
+
+func ForModulesUpdate(op internal.Operation) bool {
+	return op.UpdatingParameters.Modules != nil
+}
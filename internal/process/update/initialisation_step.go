@@ -49,6 +49,15 @@ func (s *InitialisationStep) Run(operation internal.Operation, log logrus.FieldL
 			log.Infof("waiting for %s operation (%s) to be finished", lastOp.Type, lastOp.ID)
 			return operation, time.Minute, nil
 		}
+		// the unsuspension is stored as a pending provisioning operation, which is not returned as the last operation
+		provisioning, err := s.operationStorage.GetProvisioningOperationByInstanceID(operation.InstanceID)
+		if err != nil && !dberr.IsNotFound(err) {
+			return operation, time.Minute, nil
+		}
+		if err == nil && provisioning.State == orchestration.Pending {
+			log.Infof("waiting for the unsuspension (%s) to be finished", provisioning.ID)
+			return operation, time.Minute, nil
+		}
 
 		// read the instance details (it could happen that created updating operation has outdated one)
 		instance, err := s.instanceStorage.GetByID(operation.InstanceID)
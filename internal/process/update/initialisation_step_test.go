@@ -2,6 +2,7 @@ package update
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
@@ -56,6 +57,21 @@ func TestInitialisationStep_OtherOperationIsInProgress(t *testing.T) {
 			},
 			expectedRepeat: true,
 		},
+		"pending unsuspension": {
+			beforeFunc: func(os storage.Operations) {
+				suspension := fixture.FixDeprovisioningOperation("s-id", "iid")
+				suspension.Temporary = true
+				suspension.State = domain.Succeeded
+				suspension.CreatedAt = time.Now().Add(-time.Hour)
+				err := os.InsertDeprovisioningOperation(suspension)
+				require.NoError(t, err)
+				unsuspension := fixture.FixProvisioningOperation("p-id", "iid")
+				unsuspension.State = orchestration.Pending
+				err = os.InsertOperation(unsuspension)
+				require.NoError(t, err)
+			},
+			expectedRepeat: true,
+		},
 		"in progress deprovisioning": {
 			beforeFunc: func(os storage.Operations) {
 				op := fixture.FixDeprovisioningOperation("op-id", "iid")
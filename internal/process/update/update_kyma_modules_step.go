@@ -0,0 +1,143 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/input"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/steps"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateKymaModulesStep replaces the modules section of the Kyma resource with the modules provided in the update request.
+// The same rules as in provisioning apply:
+// 1 case -> if 'default' is false, all modules are removed
+// 2 case -> if 'list' is given, the modules from the list are set (an empty list removes all modules)
+// 3 case -> if 'default' is true, the default modules from the Kyma template are set
+type UpdateKymaModulesStep struct {
+	operationManager *process.OperationManager
+	k8sClient        client.Client
+	inputBuilder     input.CreatorForPlan
+}
+
+func NewUpdateKymaModulesStep(os storage.Operations, k8sClient client.Client, b input.CreatorForPlan) *UpdateKymaModulesStep {
+	return &UpdateKymaModulesStep{
+		operationManager: process.NewOperationManager(os),
+		k8sClient:        k8sClient,
+		inputBuilder:     b,
+	}
+}
+
+func (s *UpdateKymaModulesStep) Name() string {
+	return "Update_Kyma_Modules"
+}
+
+func (s *UpdateKymaModulesStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	modulesParams := operation.UpdatingParameters.Modules
+	if modulesParams == nil {
+		log.Infof("modules not provided, skipping")
+		return operation, 0, nil
+	}
+	if operation.KymaResourceNamespace == "" {
+		log.Warnf("namespace for Kyma resource not specified, skipping")
+		return operation, 0, nil
+	}
+
+	modules, err := s.modules(operation, *modulesParams)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to prepare modules of Kyma resource", err, log)
+	}
+
+	gvk, err := k8s.GvkByName(k8s.KymaCr)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to get Kyma resource GVK", err, log)
+	}
+	kyma := &unstructured.Unstructured{}
+	kyma.SetGroupVersionKind(gvk)
+	err = s.k8sClient.Get(context.Background(), client.ObjectKey{Name: steps.KymaName(operation), Namespace: operation.KymaResourceNamespace}, kyma)
+	if errors.IsNotFound(err) {
+		log.Infof("Kyma resource not found, skipping")
+		return operation, 0, nil
+	}
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+
+	err = unstructured.SetNestedField(kyma.Object, modules, "spec", "modules")
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to set modules of Kyma resource", err, log)
+	}
+	err = s.k8sClient.Update(context.Background(), kyma)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to update Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+	log.Infof("modules of Kyma resource updated, number of modules: %d", len(modules))
+
+	return operation, 0, nil
+}
+
+// modules returns the content of the spec.modules field of the Kyma resource
+func (s *UpdateKymaModulesStep) modules(operation internal.Operation, modulesParams internal.ModulesDTO) ([]interface{}, error) {
+	switch {
+	case modulesParams.Default != nil && !*modulesParams.Default:
+		return []interface{}{}, nil
+	case modulesParams.Default == nil && modulesParams.List != nil:
+		return toUnstructuredModules(modulesParams.List)
+	default:
+		return s.defaultModules(operation)
+	}
+}
+
+func (s *UpdateKymaModulesStep) defaultModules(operation internal.Operation) ([]interface{}, error) {
+	creator, err := s.inputBuilder.CreateUpgradeShootInput(operation.ProvisioningParameters)
+	if err != nil {
+		return nil, fmt.Errorf("while creating input creator: %w", err)
+	}
+	kymaTemplate, err := steps.DecodeKymaTemplate(creator.Configuration().KymaTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("while decoding Kyma template: %w", err)
+	}
+	modules, _, err := unstructured.NestedSlice(kymaTemplate.Object, "spec", "modules")
+	if err != nil {
+		return nil, fmt.Errorf("while reading modules from Kyma template: %w", err)
+	}
+	if modules == nil {
+		return []interface{}{}, nil
+	}
+	return modules, nil
+}
+
+func toUnstructuredModules(list []internal.ModuleDTO) ([]interface{}, error) {
+	// if field is "" convert it to nil to field will be not present in the resource
+	mapIfNeeded := func(field *string) *string {
+		if field != nil && *field == "" {
+			return nil
+		}
+		return field
+	}
+
+	modules := make([]internal.ModuleDTO, 0, len(list))
+	for _, m := range list {
+		modules = append(modules, internal.ModuleDTO{
+			Name:                 m.Name,
+			Channel:              mapIfNeeded(m.Channel),
+			CustomResourcePolicy: mapIfNeeded(m.CustomResourcePolicy),
+		})
+	}
+	marshaled, err := json.Marshal(modules)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaled []interface{}
+	err = json.Unmarshal(marshaled, &unmarshaled)
+	return unmarshaled, err
+}
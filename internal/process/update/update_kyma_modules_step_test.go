@@ -0,0 +1,93 @@
+package update
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/input/automock"
+	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateKymaModulesStep(t *testing.T) {
+	for name, tc := range map[string]struct {
+		modules  internal.ModulesDTO
+		expected []interface{}
+	}{
+		"custom list of modules": {
+			modules: internal.ModulesDTO{List: []internal.ModuleDTO{
+				{Name: "btp-operator", Channel: internal.Fast, CustomResourcePolicy: internal.Ignore},
+				{Name: "keda", Channel: ptr.String(""), CustomResourcePolicy: ptr.String("")},
+			}},
+			expected: []interface{}{
+				map[string]interface{}{"name": "btp-operator", "channel": "fast", "customResourcePolicy": "Ignore"},
+				map[string]interface{}{"name": "keda"},
+			},
+		},
+		"empty list of modules": {
+			modules:  internal.ModulesDTO{List: []internal.ModuleDTO{}},
+			expected: []interface{}{},
+		},
+		"default modules disabled": {
+			modules:  internal.ModulesDTO{Default: ptr.Bool(false)},
+			expected: []interface{}{},
+		},
+		"default modules": {
+			modules: internal.ModulesDTO{Default: ptr.Bool(true)},
+			expected: []interface{}{
+				map[string]interface{}{"name": "btp-operator", "customResourcePolicy": "CreateAndDelete"},
+				map[string]interface{}{"name": "keda", "channel": "fast"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			gvk, err := k8s.GvkByName(k8s.KymaCr)
+			require.NoError(t, err)
+			kyma := &unstructured.Unstructured{}
+			kyma.SetGroupVersionKind(gvk)
+			kyma.SetName("kyma-name")
+			kyma.SetNamespace("kcp-system")
+			err = unstructured.SetNestedSlice(kyma.Object, []interface{}{map[string]interface{}{"name": "serverless"}}, "spec", "modules")
+			require.NoError(t, err)
+			kcpClient := fake.NewClientBuilder().WithRuntimeObjects(kyma).Build()
+			memoryStorage := storage.NewMemoryStorage()
+			builder := &automock.CreatorForPlan{}
+			builder.On("CreateUpgradeShootInput", mock.Anything).Return(&fixture.SimpleInputCreator{
+				Config: &internal.ConfigForPlan{KymaTemplate: internal.GetKymaTemplateForTests(t, "kyma-with-keda-and-btp-operator.yaml")},
+			}, nil)
+			step := NewUpdateKymaModulesStep(memoryStorage.Operations(), kcpClient, builder)
+
+			operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+			operation.KymaResourceName = "kyma-name"
+			operation.KymaResourceNamespace = "kcp-system"
+			operation.UpdatingParameters.Modules = &tc.modules
+
+			// when
+			_, backoff, err := step.Run(operation, logger.NewLogDummy())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, backoff)
+
+			got := &unstructured.Unstructured{}
+			got.SetGroupVersionKind(gvk)
+			err = kcpClient.Get(context.Background(), client.ObjectKey{Name: "kyma-name", Namespace: "kcp-system"}, got)
+			require.NoError(t, err)
+			modules, found, err := unstructured.NestedSlice(got.Object, "spec", "modules")
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, tc.expected, modules)
+		})
+	}
+}
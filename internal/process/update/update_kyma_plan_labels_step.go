@@ -0,0 +1,73 @@
+package update
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/steps"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateKymaPlanLabelsStep sets the plan labels on the Kyma resource after the plan of the instance was changed
+type UpdateKymaPlanLabelsStep struct {
+	operationManager *process.OperationManager
+	k8sClient        client.Client
+}
+
+func NewUpdateKymaPlanLabelsStep(os storage.Operations, k8sClient client.Client) *UpdateKymaPlanLabelsStep {
+	return &UpdateKymaPlanLabelsStep{
+		operationManager: process.NewOperationManager(os),
+		k8sClient:        k8sClient,
+	}
+}
+
+func (s *UpdateKymaPlanLabelsStep) Name() string {
+	return "Update_Kyma_Plan_Labels"
+}
+
+func (s *UpdateKymaPlanLabelsStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	if operation.KymaResourceNamespace == "" {
+		log.Warnf("namespace for Kyma resource not specified, skipping")
+		return operation, 0, nil
+	}
+	gvk, err := k8s.GvkByName(k8s.KymaCr)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to get Kyma resource GVK", err, log)
+	}
+
+	kyma := &unstructured.Unstructured{}
+	kyma.SetGroupVersionKind(gvk)
+	err = s.k8sClient.Get(context.Background(), client.ObjectKey{Name: steps.KymaName(operation), Namespace: operation.KymaResourceNamespace}, kyma)
+	if errors.IsNotFound(err) {
+		log.Infof("Kyma resource not found, skipping")
+		return operation, 0, nil
+	}
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+
+	planID := operation.ProvisioningParameters.PlanID
+	labels := kyma.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[k8s.PlanIdLabel] = planID
+	labels[k8s.PlanNameLabel] = broker.PlanNameForID(planID)
+	kyma.SetLabels(labels)
+
+	err = s.k8sClient.Update(context.Background(), kyma)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to update Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+	log.Infof("plan labels of Kyma resource set to %s", broker.PlanNameForID(planID))
+
+	return operation, 0, nil
+}
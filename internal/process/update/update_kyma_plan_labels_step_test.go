@@ -0,0 +1,75 @@
+package update
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateKymaPlanLabelsStep(t *testing.T) {
+	// given
+	gvk, err := k8s.GvkByName(k8s.KymaCr)
+	require.NoError(t, err)
+	kyma := &unstructured.Unstructured{}
+	kyma.SetGroupVersionKind(gvk)
+	kyma.SetName("kyma-name")
+	kyma.SetNamespace("kcp-system")
+	kyma.SetLabels(map[string]string{
+		k8s.PlanIdLabel:          broker.AzureLitePlanID,
+		k8s.PlanNameLabel:        broker.AzureLitePlanName,
+		k8s.GlobalAccountIdLabel: "ga-id",
+	})
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(kyma).Build()
+	memoryStorage := storage.NewMemoryStorage()
+	step := NewUpdateKymaPlanLabelsStep(memoryStorage.Operations(), kcpClient)
+
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.KymaResourceName = "kyma-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.PreviousPlanID = broker.AzureLitePlanID
+	operation.ProvisioningParameters.PlanID = broker.AzurePlanID
+
+	// when
+	_, backoff, err := step.Run(operation, logger.NewLogDummy())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(gvk)
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: "kyma-name", Namespace: "kcp-system"}, got)
+	require.NoError(t, err)
+	assert.Equal(t, broker.AzurePlanID, got.GetLabels()[k8s.PlanIdLabel])
+	assert.Equal(t, broker.AzurePlanName, got.GetLabels()[k8s.PlanNameLabel])
+	assert.Equal(t, "ga-id", got.GetLabels()[k8s.GlobalAccountIdLabel])
+}
+
+func TestUpdateKymaPlanLabelsStep_NoKymaResource(t *testing.T) {
+	// given
+	kcpClient := fake.NewClientBuilder().Build()
+	memoryStorage := storage.NewMemoryStorage()
+	step := NewUpdateKymaPlanLabelsStep(memoryStorage.Operations(), kcpClient)
+
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.KymaResourceName = "kyma-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.PreviousPlanID = broker.AzureLitePlanID
+
+	// when
+	_, backoff, err := step.Run(operation, logger.NewLogDummy())
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+}
@@ -2,13 +2,22 @@ package update
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/process/provisioning"
 
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
 	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/input"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/steps"
+	"github.com/kyma-project/kyma-environment-broker/internal/provider"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -20,13 +29,15 @@ type UpdateRuntimeStep struct {
 	operationManager *process.OperationManager
 	k8sClient        client.Client
 	delay            time.Duration
+	reloadableConfig *input.ReloadableConfig
 }
 
-func NewUpdateRuntimeStep(os storage.Operations, k8sClient client.Client, delay time.Duration) *UpdateRuntimeStep {
+func NewUpdateRuntimeStep(os storage.Operations, k8sClient client.Client, delay time.Duration, reloadableConfig *input.ReloadableConfig) *UpdateRuntimeStep {
 	return &UpdateRuntimeStep{
 		operationManager: process.NewOperationManager(os),
 		k8sClient:        k8sClient,
 		delay:            delay,
+		reloadableConfig: reloadableConfig,
 	}
 }
 
@@ -56,44 +67,98 @@ func (s *UpdateRuntimeStep) Run(operation internal.Operation, log logrus.FieldLo
 	maxUnavailable := intstr.FromInt32(int32(provisioning.DefaultIfParamNotSet(runtime.Spec.Shoot.Provider.Workers[0].MaxUnavailable.IntValue(), operation.UpdatingParameters.MaxUnavailable)))
 	runtime.Spec.Shoot.Provider.Workers[0].MaxUnavailable = &maxUnavailable
 
-	if operation.UpdatingParameters.OIDC != nil {
-		input := operation.UpdatingParameters.OIDC
-		if len(input.SigningAlgs) > 0 {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.SigningAlgs = input.SigningAlgs
-		}
-		if input.ClientID != "" {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID = &input.ClientID
+	if operation.UpdatingParameters.VolumeSizeGb != nil {
+		err = updateVolumeSize(&runtime.Spec.Shoot.Provider.Workers[0], *operation.UpdatingParameters.VolumeSizeGb)
+		if err != nil {
+			return s.operationManager.OperationFailed(operation, "unable to update volume size", err, log)
 		}
-		if input.IssuerURL != "" {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL = &input.IssuerURL
-		}
-		if input.GroupsClaim != "" {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.GroupsClaim = &input.GroupsClaim
+	}
+
+	if operation.UpdatingParameters.MultiZone != nil && *operation.UpdatingParameters.MultiZone {
+		err = enableMultiZone(&runtime, operation)
+		if err != nil {
+			return s.operationManager.OperationFailed(operation, "unable to enable multiple zones", err, log)
 		}
-		if input.UsernamePrefix != "" {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.UsernamePrefix = &input.UsernamePrefix
+	}
+
+	if operation.PreviousPlanID != "" {
+		labels := runtime.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
 		}
-		if input.UsernameClaim != "" {
-			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.UsernameClaim = &input.UsernameClaim
+		labels[k8s.PlanIdLabel] = operation.ProvisioningParameters.PlanID
+		labels[k8s.PlanNameLabel] = broker.PlanNameForID(operation.ProvisioningParameters.PlanID)
+		runtime.SetLabels(labels)
+	}
+
+	if operation.UpdatingParameters.AdditionalWorkerNodePools != nil {
+		workers := runtime.Spec.Shoot.Provider.Workers
+		runtime.Spec.Shoot.Provider.Workers = append(workers[:1:1], provisioning.AdditionalWorkers(operation.UpdatingParameters.AdditionalWorkerNodePools, workers[0], workers[1:])...)
+	}
+
+	if operation.UpdatingParameters.OIDC != nil {
+		input := operation.UpdatingParameters.OIDC
+		if len(input.List) > 0 {
+			// the list of issuers replaces the whole OIDC configuration
+			oidc, additionalOidc := steps.OIDCConfigs(input, s.reloadableConfig.OIDCDefaultValues())
+			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = oidc
+			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = additionalOidc
+		} else {
+			if len(input.SigningAlgs) > 0 {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.SigningAlgs = input.SigningAlgs
+			}
+			if input.ClientID != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID = &input.ClientID
+			}
+			if input.IssuerURL != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL = &input.IssuerURL
+			}
+			if input.GroupsClaim != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.GroupsClaim = &input.GroupsClaim
+			}
+			if input.GroupsPrefix != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.GroupsPrefix = &input.GroupsPrefix
+			}
+			if input.UsernamePrefix != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.UsernamePrefix = &input.UsernamePrefix
+			}
+			if input.UsernameClaim != "" {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.UsernameClaim = &input.UsernameClaim
+			}
+			if claims, err := input.RequiredClaimsMap(); err == nil && len(claims) > 0 {
+				runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.RequiredClaims = claims
+			}
+			// a single issuer replaces all additional issuers
+			runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = nil
 		}
 	}
 
-	if len(operation.UpdatingParameters.RuntimeAdministrators) > 0 {
-		runtime.Spec.Security.Administrators = operation.UpdatingParameters.RuntimeAdministrators
-	} else {
+	// the provisioning parameters of the update operation contain the stored administrators merged with the updated ones
+	administrators := operation.ProvisioningParameters.Parameters.RuntimeAdministrators
+	if len(administrators) == 0 {
 		if operation.ProvisioningParameters.ErsContext.UserID != "" {
 			// get default admin (user_id from provisioning operation)
-			runtime.Spec.Security.Administrators = []string{operation.ProvisioningParameters.ErsContext.UserID}
+			administrators = []string{operation.ProvisioningParameters.ErsContext.UserID}
 		} else {
 			// some old clusters does not have an user_id
-			runtime.Spec.Security.Administrators = []string{}
+			administrators = []string{}
 		}
 	}
+	addedAdministrators, removedAdministrators := administratorsDiff(runtime.Spec.Security.Administrators, administrators)
+	runtime.Spec.Security.Administrators = administrators
 
 	err = s.k8sClient.Update(context.Background(), &runtime)
 	if err != nil {
 		return s.operationManager.RetryOperation(operation, "unable to update runtime", err, 10*time.Second, 1*time.Minute, log)
 	}
+	for _, admin := range addedAdministrators {
+		log.Infof("runtime administrator %s added", admin)
+		operation.EventInfof("runtime administrator %s added", admin)
+	}
+	for _, admin := range removedAdministrators {
+		log.Infof("runtime administrator %s removed", admin)
+		operation.EventInfof("runtime administrator %s removed", admin)
+	}
 
 	// this sleep is needed to wait for the runtime to be updated by the infrastructure manager with state PENDING,
 	// then we can wait for the state READY in the next step
@@ -101,3 +166,69 @@ func (s *UpdateRuntimeStep) Run(operation internal.Operation, log logrus.FieldLo
 
 	return operation, 0, nil
 }
+
+// enableMultiZone adds zones to the Kyma worker node pool and the additional worker node pools spread across all zones, existing zones are kept
+func enableMultiZone(runtime *imv1.Runtime, operation internal.Operation) error {
+	kymaWorker := &runtime.Spec.Shoot.Provider.Workers[0]
+	zones, err := provider.MultiZones(runtime.Spec.Shoot.Provider.Type, runtime.Spec.Shoot.Region, kymaWorker.Zones)
+	if err != nil {
+		return err
+	}
+	kymaWorker.Zones = zones
+	if operation.UpdatingParameters.MaxSurge == nil {
+		maxSurge := intstr.FromInt32(int32(len(zones)))
+		kymaWorker.MaxSurge = &maxSurge
+	}
+
+	haPools := make(map[string]bool)
+	for _, pool := range operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools {
+		haPools[pool.Name] = pool.HAZones
+	}
+	for i := 1; i < len(runtime.Spec.Shoot.Provider.Workers); i++ {
+		worker := &runtime.Spec.Shoot.Provider.Workers[i]
+		if haPools[worker.Name] {
+			worker.Zones = append([]string{}, zones...)
+			maxSurge := intstr.FromInt32(int32(len(zones)))
+			worker.MaxSurge = &maxSurge
+		}
+	}
+	return nil
+}
+
+// updateVolumeSize sets the volume size of the worker, the volume size cannot be decreased
+func updateVolumeSize(worker *gardener.Worker, volumeSizeGb int) error {
+	if worker.Volume == nil {
+		return fmt.Errorf("worker %s does not have a volume", worker.Name)
+	}
+	current, err := strconv.Atoi(strings.TrimSuffix(worker.Volume.VolumeSize, "Gi"))
+	if err != nil {
+		return fmt.Errorf("while parsing volume size %s of worker %s: %w", worker.Volume.VolumeSize, worker.Name, err)
+	}
+	if volumeSizeGb < current {
+		return fmt.Errorf("volume size of worker %s cannot be decreased from %dGi to %dGi", worker.Name, current, volumeSizeGb)
+	}
+	worker.Volume.VolumeSize = fmt.Sprintf("%dGi", volumeSizeGb)
+	return nil
+}
+
+// administratorsDiff returns administrators which are present only in the desired list (added)
+// and administrators which are present only in the current list (removed)
+func administratorsDiff(current, desired []string) (added, removed []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, admin := range current {
+		currentSet[admin] = struct{}{}
+	}
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, admin := range desired {
+		desiredSet[admin] = struct{}{}
+		if _, found := currentSet[admin]; !found {
+			added = append(added, admin)
+		}
+	}
+	for _, admin := range current {
+		if _, found := desiredSet[admin]; !found {
+			removed = append(removed, admin)
+		}
+	}
+	return added, removed
+}
@@ -11,10 +11,16 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	eventsapi "github.com/kyma-project/kyma-environment-broker/common/events"
 	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/events"
 	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
 	"github.com/kyma-project/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -30,7 +36,7 @@ func TestUpdateRuntimeStep_NoRuntime(t *testing.T) {
 	assert.NoError(t, err)
 	kcpClient := fake.NewClientBuilder().Build()
 	log := logger.NewLogDummy()
-	step := NewUpdateRuntimeStep(nil, kcpClient, 0)
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
 	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
 	operation.RuntimeResourceName = "runtime-name"
 	operation.KymaResourceNamespace = "kyma-ns"
@@ -49,7 +55,7 @@ func TestUpdateRuntimeStep_RunUpdateMachineType(t *testing.T) {
 	assert.NoError(t, err)
 	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(fixRuntimeResource("runtime-name", false)).Build()
 	log := logger.NewLogDummy()
-	step := NewUpdateRuntimeStep(nil, kcpClient, 0)
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
 	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
 	operation.RuntimeResourceName = "runtime-name"
 	operation.KymaResourceNamespace = "kcp-system"
@@ -71,6 +77,302 @@ func TestUpdateRuntimeStep_RunUpdateMachineType(t *testing.T) {
 
 }
 
+func TestUpdateRuntimeStep_RunUpdateAdditionalWorkerNodePools(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtimeResource := fixRuntimeResource("runtime-name", false).(*imv1.Runtime)
+	runtimeResource.Spec.Shoot.Provider.Workers[0].Zones = []string{"zone-a", "zone-b", "zone-c"}
+	runtimeResource.Spec.Shoot.Provider.Workers = append(runtimeResource.Spec.Shoot.Provider.Workers,
+		gardener.Worker{Name: "existing", Machine: gardener.Machine{Type: "original-type"}, Minimum: 0, Maximum: 1, Zones: []string{"zone-b"}},
+		gardener.Worker{Name: "removed", Machine: gardener.Machine{Type: "original-type"}, Minimum: 0, Maximum: 1, Zones: []string{"zone-a"}},
+	)
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtimeResource).Build()
+	log := logger.NewLogDummy()
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{
+		AdditionalWorkerNodePools: []internal.AdditionalWorkerNodePool{
+			{Name: "existing", MachineType: "new-machine-type", HAZones: false, AutoScalerMin: 1, AutoScalerMax: 5},
+			{Name: "added", MachineType: "new-machine-type", HAZones: true, AutoScalerMin: 3, AutoScalerMax: 10},
+		},
+	}
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	workers := gotRuntime.Spec.Shoot.Provider.Workers
+	require.Len(t, workers, 3)
+	assert.Equal(t, "original-type", workers[0].Machine.Type)
+
+	assert.Equal(t, "existing", workers[1].Name)
+	assert.Equal(t, "new-machine-type", workers[1].Machine.Type)
+	assert.Equal(t, int32(1), workers[1].Minimum)
+	assert.Equal(t, int32(5), workers[1].Maximum)
+	assert.Equal(t, []string{"zone-b"}, workers[1].Zones)
+
+	assert.Equal(t, "added", workers[2].Name)
+	assert.Equal(t, []string{"zone-a", "zone-b", "zone-c"}, workers[2].Zones)
+}
+
+func TestUpdateRuntimeStep_RunRemoveAdditionalWorkerNodePools(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtimeResource := fixRuntimeResource("runtime-name", false).(*imv1.Runtime)
+	runtimeResource.Spec.Shoot.Provider.Workers = append(runtimeResource.Spec.Shoot.Provider.Workers,
+		gardener.Worker{Name: "removed", Machine: gardener.Machine{Type: "original-type"}, Minimum: 0, Maximum: 1},
+	)
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtimeResource).Build()
+	log := logger.NewLogDummy()
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{
+		AdditionalWorkerNodePools: []internal.AdditionalWorkerNodePool{},
+	}
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	assert.Len(t, gotRuntime.Spec.Shoot.Provider.Workers, 1)
+}
+
+func TestUpdateRuntimeStep_RunUpdatePlanLabels(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(fixRuntimeResource("runtime-name", false)).Build()
+	log := logger.NewLogDummy()
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.PreviousPlanID = broker.AzureLitePlanID
+	operation.ProvisioningParameters.PlanID = broker.AzurePlanID
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	assert.Equal(t, broker.AzurePlanID, gotRuntime.Labels[k8s.PlanIdLabel])
+	assert.Equal(t, broker.AzurePlanName, gotRuntime.Labels[k8s.PlanNameLabel])
+	assert.Equal(t, "false", gotRuntime.Labels[imv1.LabelControlledByProvisioner])
+}
+
+func TestUpdateRuntimeStep_RunUpdateOIDCIssuers(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(fixRuntimeResource("runtime-name", false)).Build()
+	log := logger.NewLogDummy()
+	defaults := internal.OIDCConfigDTO{GroupsClaim: "groups", SigningAlgs: []string{"RS256"}, UsernameClaim: "sub", UsernamePrefix: "-"}
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, defaults))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{
+		OIDC: &internal.OIDCConfigDTO{List: []internal.OIDCConfigDTO{
+			{IssuerURL: "https://issuer.local", ClientID: "client-id"},
+			{IssuerURL: "https://other.local", ClientID: "other-client-id", GroupsPrefix: "other:", RequiredClaims: []string{"aud=kyma"}},
+		}},
+	}
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	oidc := gotRuntime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig
+	assert.Equal(t, "https://issuer.local", *oidc.IssuerURL)
+	assert.Equal(t, "client-id", *oidc.ClientID)
+	assert.Equal(t, "groups", *oidc.GroupsClaim)
+	require.NotNil(t, gotRuntime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig)
+	additional := *gotRuntime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig
+	require.Len(t, additional, 1)
+	assert.Equal(t, "https://other.local", *additional[0].IssuerURL)
+	assert.Equal(t, "other-client-id", *additional[0].ClientID)
+	assert.Equal(t, "other:", *additional[0].GroupsPrefix)
+	assert.Equal(t, map[string]string{"aud": "kyma"}, additional[0].RequiredClaims)
+	assert.Equal(t, []string{"RS256"}, additional[0].SigningAlgs)
+}
+
+func TestUpdateRuntimeStep_RunUpdateAdministrators(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtimeResource := fixRuntimeResource("runtime-name", false).(*imv1.Runtime)
+	runtimeResource.Spec.Security.Administrators = []string{"admin1@test.com", "admin2@test.com"}
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtimeResource).Build()
+	log := logger.NewLogDummy()
+	eventsRecorder := events.New(events.Config{Enabled: true}, storage.NewInMemoryEvents())
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.ProvisioningParameters.Parameters.RuntimeAdministrators = []string{"admin2@test.com", "admin3@test.com"}
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin2@test.com", "admin3@test.com"}, gotRuntime.Spec.Security.Administrators)
+
+	recordedEvents, err := eventsRecorder.ListEvents(eventsapi.EventFilter{InstanceIDs: []string{operation.InstanceID}, OperationIDs: []string{operation.ID}})
+	require.NoError(t, err)
+	var messages []string
+	for _, event := range recordedEvents {
+		messages = append(messages, event.Message)
+	}
+	assert.ElementsMatch(t, []string{"runtime administrator admin3@test.com added", "runtime administrator admin1@test.com removed"}, messages)
+}
+
+func TestUpdateRuntimeStep_RunUpdateVolumeSizeAndZones(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtimeResource := fixRuntimeResource("runtime-name", false).(*imv1.Runtime)
+	runtimeResource.Spec.Shoot.Provider.Type = "gcp"
+	runtimeResource.Spec.Shoot.Region = "europe-west3"
+	runtimeResource.Spec.Shoot.Provider.Workers[0].Zones = []string{"europe-west3-b"}
+	runtimeResource.Spec.Shoot.Provider.Workers[0].Volume = &gardener.Volume{VolumeSize: "80Gi"}
+	runtimeResource.Spec.Shoot.Provider.Workers = append(runtimeResource.Spec.Shoot.Provider.Workers,
+		gardener.Worker{Name: "ha-pool", Machine: gardener.Machine{Type: "original-type"}, Minimum: 3, Maximum: 10, Zones: []string{"europe-west3-b"}},
+		gardener.Worker{Name: "single-zone-pool", Machine: gardener.Machine{Type: "original-type"}, Minimum: 0, Maximum: 1, Zones: []string{"europe-west3-b"}},
+	)
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtimeResource).Build()
+	log := logger.NewLogDummy()
+	step := NewUpdateRuntimeStep(nil, kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools = []internal.AdditionalWorkerNodePool{
+		{Name: "ha-pool", MachineType: "original-type", HAZones: true, AutoScalerMin: 3, AutoScalerMax: 10},
+		{Name: "single-zone-pool", MachineType: "original-type", HAZones: false, AutoScalerMin: 0, AutoScalerMax: 1},
+	}
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{
+		VolumeSizeGb: ptr.Integer(120),
+		MultiZone:    ptr.Bool(true),
+	}
+
+	// when
+	_, backoff, err := step.Run(operation, log)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, backoff)
+
+	var gotRuntime imv1.Runtime
+	err = kcpClient.Get(context.Background(), client.ObjectKey{Name: operation.RuntimeResourceName, Namespace: "kcp-system"}, &gotRuntime)
+	require.NoError(t, err)
+	workers := gotRuntime.Spec.Shoot.Provider.Workers
+	require.Len(t, workers, 3)
+	assert.Equal(t, "120Gi", workers[0].Volume.VolumeSize)
+	require.Len(t, workers[0].Zones, 3)
+	assert.Equal(t, "europe-west3-b", workers[0].Zones[0])
+	assert.ElementsMatch(t, []string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}, workers[0].Zones)
+	assert.Equal(t, 3, workers[0].MaxSurge.IntValue())
+	assert.Equal(t, workers[0].Zones, workers[1].Zones)
+	assert.Equal(t, []string{"europe-west3-b"}, workers[2].Zones)
+}
+
+func TestUpdateRuntimeStep_RunDecreaseVolumeSize(t *testing.T) {
+	// given
+	err := imv1.AddToScheme(scheme.Scheme)
+	assert.NoError(t, err)
+	runtimeResource := fixRuntimeResource("runtime-name", false).(*imv1.Runtime)
+	runtimeResource.Spec.Shoot.Provider.Workers[0].Volume = &gardener.Volume{VolumeSize: "100Gi"}
+	kcpClient := fake.NewClientBuilder().WithRuntimeObjects(runtimeResource).Build()
+	memoryStorage := storage.NewMemoryStorage()
+	step := NewUpdateRuntimeStep(memoryStorage.Operations(), kcpClient, 0, input.NewReloadableConfig(nil, internal.OIDCConfigDTO{}))
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.RuntimeResourceName = "runtime-name"
+	operation.KymaResourceNamespace = "kcp-system"
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{
+		VolumeSizeGb: ptr.Integer(80),
+	}
+	err = memoryStorage.Operations().InsertOperation(operation)
+	require.NoError(t, err)
+
+	// when
+	_, _, err = step.Run(operation, logger.NewLogDummy())
+
+	// then
+	assert.ErrorContains(t, err, "cannot be decreased from 100Gi to 80Gi")
+}
+
+func TestAdministratorsDiff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		current         []string
+		desired         []string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		"no changes": {
+			current: []string{"admin1", "admin2"},
+			desired: []string{"admin2", "admin1"},
+		},
+		"added and removed": {
+			current:         []string{"admin1", "admin2"},
+			desired:         []string{"admin2", "admin3"},
+			expectedAdded:   []string{"admin3"},
+			expectedRemoved: []string{"admin1"},
+		},
+		"no current administrators": {
+			desired:       []string{"admin1"},
+			expectedAdded: []string{"admin1"},
+		},
+		"all removed": {
+			current:         []string{"admin1"},
+			desired:         []string{},
+			expectedRemoved: []string{"admin1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			added, removed := administratorsDiff(tc.current, tc.desired)
+
+			// then
+			assert.Equal(t, tc.expectedAdded, added)
+			assert.Equal(t, tc.expectedRemoved, removed)
+		})
+	}
+}
+
 func fixRuntimeResource(name string, controlledByProvisioner bool) runtime.Object {
 	maxSurge := intstr.FromInt32(1)
 	maxUnavailable := intstr.FromInt32(0)
@@ -143,7 +143,7 @@ func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 	ibf, err := input.NewInputBuilderFactory(configProvider, input.Config{
 		KubernetesVersion:           k8sVersion,
 		DefaultGardenerShootPurpose: "test",
-	}, fixTrialRegionMapping(), fixFreemiumProviders(), fixture.FixOIDCConfigDTO(), false)
+	}, input.NewReloadableConfig(fixTrialRegionMapping(), fixture.FixOIDCConfigDTO()), fixFreemiumProviders(), false)
 	assert.NoError(t, err)
 
 	pp := internal.ProvisioningParameters{
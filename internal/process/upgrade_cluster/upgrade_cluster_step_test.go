@@ -132,7 +132,7 @@ func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 		TrialNodesNumber:              1,
 		AutoUpdateKubernetesVersion:   fixAutoUpdateKubernetesVersion,
 		AutoUpdateMachineImageVersion: fixAutoUpdateMachineImageVersion,
-	}, nil, nil, fixture.FixOIDCConfigDTO(), false)
+	}, input.NewReloadableConfig(nil, fixture.FixOIDCConfigDTO()), nil, false)
 	require.NoError(t, err, "Input factory creation error")
 
 	creator, err := ibf.CreateUpgradeShootInput(fixProvisioningParameters())
@@ -0,0 +1,81 @@
+package upgrade_kyma
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+type InitialisationStep struct {
+	operationManager     *process.OperationManager
+	operationStorage     storage.Operations
+	orchestrationStorage storage.Orchestrations
+	retry                time.Duration
+	statusCheck          time.Duration
+}
+
+func NewInitialisationStep(os storage.Operations, ors storage.Orchestrations) *InitialisationStep {
+	return &InitialisationStep{
+		operationManager:     process.NewOperationManager(os),
+		operationStorage:     os,
+		orchestrationStorage: ors,
+		retry:                5 * time.Second,
+		statusCheck:          time.Minute,
+	}
+}
+
+func (s *InitialisationStep) Name() string {
+	return "Upgrade_Kyma_Initialisation"
+}
+
+func (s *InitialisationStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	// Check concurrent deprovisioning (or suspension) operation (launched after target resolution)
+	// Terminate (preempt) upgrade immediately with succeeded
+	lastOp, err := s.operationStorage.GetLastOperation(operation.InstanceID)
+	if err != nil {
+		return operation, s.retry, nil
+	}
+	if lastOp.Type == internal.OperationTypeDeprovision {
+		return s.operationManager.OperationSucceeded(operation, fmt.Sprintf("operation preempted by deprovisioning %s", lastOp.ID), log)
+	}
+
+	if operation.State != orchestration.Pending {
+		return operation, 0, nil
+	}
+
+	// Check if the orchestration got cancelled, don't start new pending operation
+	o, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return operation, s.retry, nil
+	}
+	if o.IsCanceled() {
+		log.Infof("Skipping processing because orchestration %s was canceled", operation.OrchestrationID)
+		return s.operationManager.OperationCanceled(operation, fmt.Sprintf("orchestration %s was canceled", operation.OrchestrationID), log)
+	}
+
+	// Check concurrent operations and wait to finish before proceeding
+	// - unsuspension provisioning launched after suspension
+	// - update or cluster upgrade changing the Kyma resource or the cluster
+	switch lastOp.Type {
+	case internal.OperationTypeProvision, internal.OperationTypeUpdate, internal.OperationTypeUpgradeCluster:
+		if !lastOp.IsFinished() {
+			return operation, s.statusCheck, nil
+		}
+	}
+
+	op, delay, _ := s.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+		op.ProvisioningParameters.ErsContext = internal.InheritMissingERSContext(op.ProvisioningParameters.ErsContext, lastOp.ProvisioningParameters.ErsContext)
+		op.State = domain.InProgress
+		op.Description = "kyma upgrade in progress"
+	}, log)
+	if delay != 0 {
+		return operation, delay, nil
+	}
+	return op, 0, nil
+}
@@ -0,0 +1,84 @@
+package upgrade_kyma
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialisationStep_Run(t *testing.T) {
+	for name, tc := range map[string]struct {
+		orchestrationState string
+		lastOpType         internal.OperationType
+		lastOpState        domain.LastOperationState
+		expectedState      domain.LastOperationState
+		expectedRepeat     bool
+	}{
+		"should start pending operation": {
+			orchestrationState: orchestration.InProgress,
+			lastOpType:         internal.OperationTypeProvision,
+			lastOpState:        domain.Succeeded,
+			expectedState:      domain.InProgress,
+		},
+		"should cancel pending operation of canceled orchestration": {
+			orchestrationState: orchestration.Canceling,
+			lastOpType:         internal.OperationTypeProvision,
+			lastOpState:        domain.Succeeded,
+			expectedState:      orchestration.Canceled,
+		},
+		"should wait for the update in progress": {
+			orchestrationState: orchestration.InProgress,
+			lastOpType:         internal.OperationTypeUpdate,
+			lastOpState:        domain.InProgress,
+			expectedState:      orchestration.Pending,
+			expectedRepeat:     true,
+		},
+		"should be preempted by deprovisioning": {
+			orchestrationState: orchestration.InProgress,
+			lastOpType:         internal.OperationTypeDeprovision,
+			lastOpState:        domain.InProgress,
+			expectedState:      domain.Succeeded,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			err := memoryStorage.Orchestrations().Insert(internal.Orchestration{
+				OrchestrationID: "orchestration-id",
+				Type:            orchestration.UpgradeKymaOrchestration,
+				State:           tc.orchestrationState,
+			})
+			require.NoError(t, err)
+
+			operation := fixture.FixOperation("op-id", "inst-id", internal.OperationTypeUpgradeKyma)
+			operation.State = orchestration.Pending
+			operation.OrchestrationID = "orchestration-id"
+			err = memoryStorage.Operations().InsertOperation(operation)
+			require.NoError(t, err)
+
+			lastOp := fixture.FixOperation("last-op-id", "inst-id", tc.lastOpType)
+			lastOp.State = tc.lastOpState
+			lastOp.CreatedAt = time.Now().Add(time.Hour)
+			err = memoryStorage.Operations().InsertOperation(lastOp)
+			require.NoError(t, err)
+
+			step := NewInitialisationStep(memoryStorage.Operations(), memoryStorage.Orchestrations())
+
+			// when
+			op, repeat, err := step.Run(operation, logger.NewLogDummy())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRepeat, repeat != 0)
+			assert.Equal(t, tc.expectedState, op.State)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package upgrade_kyma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/kyma-environment-broker/internal/process/steps"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateKymaResourceStep applies the Kyma parameters of the orchestration to the Kyma resource of the runtime:
+// the channel is replaced, the given modules are added or replace the modules with the same name, and the modules to remove are removed.
+// If modules are changed, the modules of the Kyma resource are stored as the custom list of modules of the instance.
+type UpdateKymaResourceStep struct {
+	operationManager     *process.OperationManager
+	instanceStorage      storage.Instances
+	orchestrationStorage storage.Orchestrations
+	k8sClient            client.Client
+}
+
+func NewUpdateKymaResourceStep(os storage.Operations, is storage.Instances, ors storage.Orchestrations, k8sClient client.Client) *UpdateKymaResourceStep {
+	return &UpdateKymaResourceStep{
+		operationManager:     process.NewOperationManager(os),
+		instanceStorage:      is,
+		orchestrationStorage: ors,
+		k8sClient:            k8sClient,
+	}
+}
+
+func (s *UpdateKymaResourceStep) Name() string {
+	return "Update_Kyma_Resource"
+}
+
+func (s *UpdateKymaResourceStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	o, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get orchestration", err, 5*time.Second, 1*time.Minute, log)
+	}
+	params := o.Parameters.Kyma
+	if params == nil {
+		return s.operationManager.OperationFailed(operation, "kyma parameters of the orchestration not provided", nil, log)
+	}
+	if operation.KymaResourceNamespace == "" {
+		return s.operationManager.OperationFailed(operation, "namespace of the Kyma resource not specified", nil, log)
+	}
+
+	gvk, err := k8s.GvkByName(k8s.KymaCr)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to get Kyma resource GVK", err, log)
+	}
+	kyma := &unstructured.Unstructured{}
+	kyma.SetGroupVersionKind(gvk)
+	err = s.k8sClient.Get(context.Background(), client.ObjectKey{Name: steps.KymaName(operation), Namespace: operation.KymaResourceNamespace}, kyma)
+	if errors.IsNotFound(err) {
+		return s.operationManager.OperationFailed(operation, "Kyma resource not found", err, log)
+	}
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+
+	if err := applyKymaParameters(kyma, *params); err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to apply kyma parameters to Kyma resource", err, log)
+	}
+
+	if operation.DryRun {
+		return s.operationManager.OperationSucceeded(operation, "dry run succeeded", log)
+	}
+
+	err = s.k8sClient.Update(context.Background(), kyma)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to update Kyma resource", err, 10*time.Second, 1*time.Minute, log)
+	}
+	log.Infof("Kyma resource %s updated", kyma.GetName())
+
+	if len(params.Modules) > 0 || len(params.RemoveModules) > 0 {
+		if err := s.updateInstanceModules(operation.InstanceID, kyma); err != nil {
+			return s.operationManager.RetryOperation(operation, "unable to update modules of the instance", err, 5*time.Second, 1*time.Minute, log)
+		}
+	}
+
+	return s.operationManager.OperationSucceeded(operation, "Kyma resource updated", log)
+}
+
+// updateInstanceModules stores modules of the Kyma resource in the instance parameters, so the next update of the instance starts from them
+func (s *UpdateKymaResourceStep) updateInstanceModules(instanceID string, kyma *unstructured.Unstructured) error {
+	modules, _, err := unstructured.NestedSlice(kyma.Object, "spec", "modules")
+	if err != nil {
+		return fmt.Errorf("while reading modules: %w", err)
+	}
+	marshaled, err := json.Marshal(modules)
+	if err != nil {
+		return fmt.Errorf("while marshaling modules: %w", err)
+	}
+	list := make([]internal.ModuleDTO, 0, len(modules))
+	if err := json.Unmarshal(marshaled, &list); err != nil {
+		return fmt.Errorf("while unmarshaling modules: %w", err)
+	}
+
+	instance, err := s.instanceStorage.GetByID(instanceID)
+	if err != nil {
+		return fmt.Errorf("while getting instance: %w", err)
+	}
+	instance.Parameters.Parameters.Modules = &internal.ModulesDTO{List: list}
+	if _, err := s.instanceStorage.Update(*instance); err != nil {
+		return fmt.Errorf("while updating instance: %w", err)
+	}
+	return nil
+}
+
+func applyKymaParameters(kyma *unstructured.Unstructured, params orchestration.KymaParameters) error {
+	if params.Channel != "" {
+		if err := unstructured.SetNestedField(kyma.Object, params.Channel, "spec", "channel"); err != nil {
+			return fmt.Errorf("while setting channel: %w", err)
+		}
+	}
+	if len(params.Modules) == 0 && len(params.RemoveModules) == 0 {
+		return nil
+	}
+
+	current, _, err := unstructured.NestedSlice(kyma.Object, "spec", "modules")
+	if err != nil {
+		return fmt.Errorf("while reading modules: %w", err)
+	}
+	modules := make([]interface{}, 0, len(current)+len(params.Modules))
+	for _, m := range current {
+		if slices.Contains(params.RemoveModules, moduleName(m)) {
+			continue
+		}
+		modules = append(modules, m)
+	}
+	for _, m := range params.Modules {
+		module := map[string]interface{}{"name": m.Name}
+		if m.Channel != "" {
+			module["channel"] = m.Channel
+		}
+		if m.CustomResourcePolicy != "" {
+			module["customResourcePolicy"] = m.CustomResourcePolicy
+		}
+		idx := slices.IndexFunc(modules, func(existing interface{}) bool {
+			return moduleName(existing) == m.Name
+		})
+		if idx >= 0 {
+			modules[idx] = module
+		} else {
+			modules = append(modules, module)
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(kyma.Object, modules, "spec", "modules"); err != nil {
+		return fmt.Errorf("while setting modules: %w", err)
+	}
+	return nil
+}
+
+func moduleName(module interface{}) string {
+	m, ok := module.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	name, _, _ := unstructured.NestedString(m, "name")
+	return name
+}
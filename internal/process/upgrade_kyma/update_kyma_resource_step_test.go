@@ -0,0 +1,157 @@
+package upgrade_kyma
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/kyma-environment-broker/internal/k8s"
+	"github.com/kyma-project/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateKymaResourceStep(t *testing.T) {
+	for name, tc := range map[string]struct {
+		params                  orchestration.KymaParameters
+		dryRun                  bool
+		expectedChannel         string
+		expectedModules         []interface{}
+		expectedInstanceModules *internal.ModulesDTO
+	}{
+		"channel": {
+			params:          orchestration.KymaParameters{Channel: "fast"},
+			expectedChannel: "fast",
+			expectedModules: []interface{}{
+				map[string]interface{}{"name": "serverless"},
+				map[string]interface{}{"name": "keda", "channel": "regular"},
+			},
+		},
+		"modules added, replaced and removed": {
+			params: orchestration.KymaParameters{
+				Modules: []orchestration.KymaModule{
+					{Name: "keda", Channel: "fast", CustomResourcePolicy: "Ignore"},
+					{Name: "btp-operator"},
+				},
+				RemoveModules: []string{"serverless"},
+			},
+			expectedChannel: "regular",
+			expectedModules: []interface{}{
+				map[string]interface{}{"name": "keda", "channel": "fast", "customResourcePolicy": "Ignore"},
+				map[string]interface{}{"name": "btp-operator"},
+			},
+			expectedInstanceModules: &internal.ModulesDTO{List: []internal.ModuleDTO{
+				{Name: "keda", Channel: ptr.String("fast"), CustomResourcePolicy: ptr.String("Ignore")},
+				{Name: "btp-operator"},
+			}},
+		},
+		"dry run": {
+			params:          orchestration.KymaParameters{Channel: "fast", RemoveModules: []string{"keda"}},
+			dryRun:          true,
+			expectedChannel: "regular",
+			expectedModules: []interface{}{
+				map[string]interface{}{"name": "serverless"},
+				map[string]interface{}{"name": "keda", "channel": "regular"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			gvk, err := k8s.GvkByName(k8s.KymaCr)
+			require.NoError(t, err)
+			kyma := &unstructured.Unstructured{}
+			kyma.SetGroupVersionKind(gvk)
+			kyma.SetName("kyma-name")
+			kyma.SetNamespace("kcp-system")
+			err = unstructured.SetNestedField(kyma.Object, "regular", "spec", "channel")
+			require.NoError(t, err)
+			err = unstructured.SetNestedSlice(kyma.Object, []interface{}{
+				map[string]interface{}{"name": "serverless"},
+				map[string]interface{}{"name": "keda", "channel": "regular"},
+			}, "spec", "modules")
+			require.NoError(t, err)
+			kcpClient := fake.NewClientBuilder().WithRuntimeObjects(kyma).Build()
+
+			memoryStorage := storage.NewMemoryStorage()
+			params := tc.params
+			err = memoryStorage.Orchestrations().Insert(internal.Orchestration{
+				OrchestrationID: "orchestration-id",
+				Type:            orchestration.UpgradeKymaOrchestration,
+				Parameters:      orchestration.Parameters{Kyma: &params, DryRun: tc.dryRun},
+			})
+			require.NoError(t, err)
+
+			operation := fixture.FixOperation("op-id", "inst-id", internal.OperationTypeUpgradeKyma)
+			operation.State = domain.InProgress
+			operation.OrchestrationID = "orchestration-id"
+			operation.DryRun = tc.dryRun
+			operation.KymaResourceName = "kyma-name"
+			operation.KymaResourceNamespace = "kcp-system"
+			err = memoryStorage.Operations().InsertOperation(operation)
+			require.NoError(t, err)
+			err = memoryStorage.Instances().Insert(fixture.FixInstance("inst-id"))
+			require.NoError(t, err)
+
+			step := NewUpdateKymaResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), memoryStorage.Orchestrations(), kcpClient)
+
+			// when
+			op, backoff, err := step.Run(operation, logger.NewLogDummy())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, backoff)
+			assert.Equal(t, domain.Succeeded, op.State)
+
+			got := &unstructured.Unstructured{}
+			got.SetGroupVersionKind(gvk)
+			err = kcpClient.Get(context.Background(), client.ObjectKey{Name: "kyma-name", Namespace: "kcp-system"}, got)
+			require.NoError(t, err)
+			channel, _, err := unstructured.NestedString(got.Object, "spec", "channel")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChannel, channel)
+			modules, _, err := unstructured.NestedSlice(got.Object, "spec", "modules")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedModules, modules)
+
+			instance, err := memoryStorage.Instances().GetByID("inst-id")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInstanceModules, instance.Parameters.Parameters.Modules)
+		})
+	}
+}
+
+func TestUpdateKymaResourceStep_KymaResourceNotFound(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	err := memoryStorage.Orchestrations().Insert(internal.Orchestration{
+		OrchestrationID: "orchestration-id",
+		Type:            orchestration.UpgradeKymaOrchestration,
+		Parameters:      orchestration.Parameters{Kyma: &orchestration.KymaParameters{Channel: "fast"}},
+	})
+	require.NoError(t, err)
+
+	operation := fixture.FixOperation("op-id", "inst-id", internal.OperationTypeUpgradeKyma)
+	operation.State = domain.InProgress
+	operation.OrchestrationID = "orchestration-id"
+	operation.KymaResourceNamespace = "kcp-system"
+	err = memoryStorage.Operations().InsertOperation(operation)
+	require.NoError(t, err)
+
+	step := NewUpdateKymaResourceStep(memoryStorage.Operations(), memoryStorage.Instances(), memoryStorage.Orchestrations(), fake.NewClientBuilder().Build())
+
+	// when
+	op, backoff, err := step.Run(operation, logger.NewLogDummy())
+
+	// then
+	require.Error(t, err)
+	assert.Zero(t, backoff)
+	assert.Equal(t, domain.Failed, op.State)
+}
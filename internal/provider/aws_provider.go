@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/networking"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/kyma-project/kyma-environment-broker/internal"
@@ -82,29 +83,13 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 	}
 }
 
-// awsZones defines a possible suffixes for given AWS regions
-// The table is tested in a unit test to check if all necessary regions are covered
-var awsZones = map[string]string{
-	"eu-central-1":   "abc",
-	"eu-west-2":      "abc",
-	"ca-central-1":   "abd",
-	"sa-east-1":      "abc",
-	"us-east-1":      "abcdf",
-	"us-west-1":      "ab",
-	"ap-northeast-1": "acd",
-	"ap-northeast-2": "abc",
-	"ap-south-1":     "abc",
-	"ap-southeast-1": "abc",
-	"ap-southeast-2": "abc",
-}
-
 func ZoneForAWSRegion(region string) string {
-	zones, found := awsZones[region]
+	zones, found := regions.Zones(regions.AWS, region)
 	if !found {
-		zones = "a"
+		zones = []string{"a"}
 	}
 
-	zone := string(zones[rand.Intn(len(zones))])
+	zone := zones[rand.Intn(len(zones))]
 	return fmt.Sprintf("%s%s", region, zone)
 }
 
@@ -8,16 +8,17 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAWSZones(t *testing.T) {
-	regions := broker.AWSRegions(false)
-	for _, region := range regions {
-		_, exists := awsZones[region]
+	awsRegions := broker.AWSRegions(false)
+	for _, region := range awsRegions {
+		_, exists := regions.Zones(regions.AWS, region)
 		assert.True(t, exists)
 	}
-	_, exists := awsZones[DefaultAWSRegion]
+	_, exists := regions.Zones(regions.AWS, DefaultAWSRegion)
 	assert.True(t, exists)
 }
 
@@ -118,12 +119,12 @@ func assertAWSIpRanges(t *testing.T, zone gqlschema.AWSZoneInput, input *gqlsche
 }
 
 func TestAWSZonesForEuAccess(t *testing.T) {
-	regions := broker.AWSRegions(true)
-	for _, region := range regions {
-		_, exists := awsZones[region]
+	awsRegions := broker.AWSRegions(true)
+	for _, region := range awsRegions {
+		_, exists := regions.Zones(regions.AWS, region)
 		assert.True(t, exists)
 	}
-	_, exists := awsZones[DefaultEuAccessAWSRegion]
+	_, exists := regions.Zones(regions.AWS, DefaultEuAccessAWSRegion)
 	assert.True(t, exists)
 }
 
@@ -160,7 +161,8 @@ func TestMultipleZonesForAWSRegion(t *testing.T) {
 		// given
 		region := "us-east-1"
 		zonesCountExceedingMaximum := 20
-		maximumZonesForRegion := len(awsZones[region])
+		zones, _ := regions.Zones(regions.AWS, region)
+		maximumZonesForRegion := len(zones)
 		// "us-east-1" region has maximum 6 zones, user request 20
 
 		// when
@@ -20,7 +20,7 @@ func GenerateValues(
 	defaultPurpose string,
 ) (Values, error) {
 	var p Provider
-	switch operation.ProvisioningParameters.PlanID {
+	switch broker.BasePlanID(operation.ProvisioningParameters.PlanID) {
 	case broker.AWSPlanID:
 		p = &AWSInputProvider{
 			Purpose:                defaultPurpose,
@@ -104,5 +104,21 @@ func GenerateValues(
 	default:
 		return Values{}, fmt.Errorf("plan %s not supported", operation.ProvisioningParameters.PlanID)
 	}
-	return p.Provide(), nil
+	values := p.Provide()
+	if definition, found := broker.PlanDefinitionForID(operation.ProvisioningParameters.PlanID); found {
+		applyPlanDefinition(&values, definition)
+	}
+	return values, nil
+}
+
+func applyPlanDefinition(values *Values, definition broker.PlanDefinition) {
+	if definition.DefaultMachineType != "" {
+		values.DefaultMachineType = definition.DefaultMachineType
+	}
+	if definition.DefaultAutoScalerMin > 0 {
+		values.DefaultAutoScalerMin = definition.DefaultAutoScalerMin
+	}
+	if definition.DefaultAutoScalerMax > 0 {
+		values.DefaultAutoScalerMax = definition.DefaultAutoScalerMax
+	}
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+
 	"gopkg.in/yaml.v2"
 )
 
@@ -19,3 +21,15 @@ func ReadPlatformRegionMappingFromFile(filename string) (map[string]string, erro
 	}
 	return data, nil
 }
+
+// ValidatePlatformRegionMapping checks if every platform region is mapped to one of the trial regions
+func ValidatePlatformRegionMapping(mapping map[string]string) error {
+	for platformRegion, trialRegion := range mapping {
+		switch broker.TrialCloudRegion(trialRegion) {
+		case broker.Europe, broker.Us, broker.Asia:
+		default:
+			return fmt.Errorf("platform region %s is mapped to unknown trial region %q", platformRegion, trialRegion)
+		}
+	}
+	return nil
+}
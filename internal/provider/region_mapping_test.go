@@ -16,3 +16,17 @@ func TestReadPlatformRegionMappingFromFile(t *testing.T) {
 	assert.Equal(t, "europe", d["cf-eu"])
 	assert.Equal(t, "us", d["cf-us"])
 }
+
+func TestValidatePlatformRegionMapping(t *testing.T) {
+	// when
+	err := ValidatePlatformRegionMapping(map[string]string{"cf-eu": "europe", "cf-us": "us", "cf-ap": "asia"})
+
+	// then
+	assert.NoError(t, err)
+
+	// when
+	err = ValidatePlatformRegionMapping(map[string]string{"cf-eu": "europe", "cf-sa": "south-america"})
+
+	// then
+	assert.EqualError(t, err, `platform region cf-sa is mapped to unknown trial region "south-america"`)
+}
@@ -4,16 +4,17 @@ import (
 	"testing"
 
 	"github.com/kyma-project/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestZonesForSapConvergedCloudZones(t *testing.T) {
 	convergedCloudRegionProvider := broker.OneForAllConvergedCloudRegionsProvider{}
-	regions := convergedCloudRegionProvider.GetRegions("")
-	for _, region := range regions {
-		_, exists := sapConvergedCloudZones[region]
+	convergedCloudRegions := convergedCloudRegionProvider.GetRegions("")
+	for _, region := range convergedCloudRegions {
+		_, exists := regions.Zones(regions.SapConvergedCloud, region)
 		assert.True(t, exists)
 	}
-	_, exists := sapConvergedCloudZones[DefaultSapConvergedCloudRegion]
+	_, exists := regions.Zones(regions.SapConvergedCloud, DefaultSapConvergedCloudRegion)
 	assert.True(t, exists)
 }
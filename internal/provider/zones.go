@@ -2,8 +2,11 @@ package provider
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
-	"strings"
+	"slices"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 )
 
 func GenerateAzureZones(zonesCount int) []string {
@@ -16,14 +19,14 @@ func GenerateAzureZones(zonesCount int) []string {
 	return zones[:zonesCount]
 }
 
+// MultipleZonesForAWSRegion returns zones of the region defined in the regions configuration
 func MultipleZonesForAWSRegion(region string, zonesCount int) []string {
-	zones, found := awsZones[region]
+	availableZones, found := regions.Zones(regions.AWS, region)
 	if !found {
-		zones = "a"
+		availableZones = []string{"a"}
 		zonesCount = 1
 	}
 
-	availableZones := strings.Split(zones, "")
 	rand.Shuffle(len(availableZones), func(i, j int) { availableZones[i], availableZones[j] = availableZones[j], availableZones[i] })
 	if zonesCount > len(availableZones) {
 		// get maximum number of zones for region
@@ -39,20 +42,8 @@ func MultipleZonesForAWSRegion(region string, zonesCount int) []string {
 	return generatedZones
 }
 
-// sapConvergedCloudZones defines a possible suffixes for given OpenStack regions
-// The table is tested in a unit test to check if all necessary regions are covered
-var sapConvergedCloudZones = map[string]string{
-	"eu-de-1": "abd",
-	"ap-au-1": "ab",
-	"na-us-1": "abd",
-	"eu-de-2": "ab",
-	"na-us-2": "ab",
-	"ap-jp-1": "a",
-	"ap-ae-1": "ab",
-}
-
 func CountZonesForSapConvergedCloud(region string) int {
-	zones, found := sapConvergedCloudZones[region]
+	zones, found := regions.Zones(regions.SapConvergedCloud, region)
 	if !found {
 		return 0
 	}
@@ -61,13 +52,12 @@ func CountZonesForSapConvergedCloud(region string) int {
 }
 
 func ZonesForSapConvergedCloud(region string, zonesCount int) []string {
-	zones, found := sapConvergedCloudZones[region]
+	availableZones, found := regions.Zones(regions.SapConvergedCloud, region)
 	if !found {
-		zones = "a"
+		availableZones = []string{"a"}
 		zonesCount = 1
 	}
 
-	availableZones := strings.Split(zones, "")
 	rand.Shuffle(len(availableZones), func(i, j int) { availableZones[i], availableZones[j] = availableZones[j], availableZones[i] })
 	if zonesCount > len(availableZones) {
 		// get maximum number of zones for region
@@ -82,3 +72,34 @@ func ZonesForSapConvergedCloud(region string, zonesCount int) []string {
 	}
 	return generatedZones
 }
+
+// MultiZones returns zones of a multi-zone cluster of the given provider type in the region.
+// The current zones are kept and the missing zones are added up to the default multi-zone count of the provider.
+func MultiZones(providerType, region string, current []string) ([]string, error) {
+	var zonesCount int
+	var available []string
+	switch providerType {
+	case "aws":
+		zonesCount = DefaultAWSMultiZoneCount
+		available = MultipleZonesForAWSRegion(region, math.MaxInt)
+	case "azure":
+		zonesCount = DefaultAzureMultiZoneCount
+		available = GenerateAzureZones(DefaultAzureMultiZoneCount)
+	case "gcp":
+		zonesCount = DefaultGCPMultiZoneCount
+		available = ZonesForGCPRegion(region, DefaultGCPMultiZoneCount)
+	default:
+		return nil, fmt.Errorf("multiple zones are not supported for the %s provider", providerType)
+	}
+
+	zones := append([]string{}, current...)
+	for _, zone := range available {
+		if len(zones) >= zonesCount {
+			break
+		}
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+	return zones, nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/kyma-project/kyma-environment-broker/internal/regions"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,7 +71,8 @@ func TestMultipleZonesForSapConvergedCloudRegion(t *testing.T) {
 		// given
 		region := "eu-de-1"
 		zonesCountExceedingMaximum := 20
-		maximumZonesForRegion := len(sapConvergedCloudZones[region])
+		zones, _ := regions.Zones(regions.SapConvergedCloud, region)
+		maximumZonesForRegion := len(zones)
 		// "eu-de-1" region has maximum 3 zones, user request 20
 
 		// when
@@ -84,3 +86,42 @@ func TestMultipleZonesForSapConvergedCloudRegion(t *testing.T) {
 		assert.Equal(t, maximumZonesForRegion, len(generatedZones))
 	})
 }
+
+func TestMultiZones(t *testing.T) {
+	t.Run("should keep the current zone and add zones up to the multi-zone count", func(t *testing.T) {
+		// when
+		zones, err := MultiZones("gcp", "europe-west3", []string{"europe-west3-c"})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "europe-west3-c", zones[0])
+		assert.ElementsMatch(t, []string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}, zones)
+	})
+
+	t.Run("should add Azure zones", func(t *testing.T) {
+		// when
+		zones, err := MultiZones("azure", "westeurope", []string{"2"})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "2", zones[0])
+		assert.ElementsMatch(t, []string{"1", "2", "3"}, zones)
+	})
+
+	t.Run("should not change zones of a multi-zone cluster", func(t *testing.T) {
+		// when
+		zones, err := MultiZones("aws", "eu-central-1", []string{"eu-central-1b", "eu-central-1a", "eu-central-1c"})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"eu-central-1b", "eu-central-1a", "eu-central-1c"}, zones)
+	})
+
+	t.Run("should fail for a provider without multiple zones support", func(t *testing.T) {
+		// when
+		_, err := MultiZones("openstack", "eu-de-1", []string{"eu-de-1a"})
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,246 @@
+package regions
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	AWS               = "aws"
+	Azure             = "azure"
+	AzureLite         = "azure_lite"
+	GCP               = "gcp"
+	SapConvergedCloud = "sap-converged-cloud"
+)
+
+type Region struct {
+	Name             string   `yaml:"name"`
+	DisplayName      string   `yaml:"displayName"`
+	Zones            []string `yaml:"zones"`
+	EUAccess         bool     `yaml:"euAccess"`
+	AssuredWorkloads bool     `yaml:"assuredWorkloads"`
+	RestrictedOnly   bool     `yaml:"restrictedOnly"`
+}
+
+type MachineType struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"displayName"`
+}
+
+// Config contains regions per hyperscaler and machine types per hyperscaler (azure_lite has its own machine types)
+type Config struct {
+	Regions      map[string][]Region      `yaml:"regions"`
+	MachineTypes map[string][]MachineType `yaml:"machineTypes"`
+}
+
+//go:embed default.yaml
+var defaultConfigYAML []byte
+
+var (
+	mu      sync.RWMutex
+	current = mustParseDefault()
+)
+
+func mustParseDefault() Config {
+	cfg, err := parse(defaultConfigYAML, Config{})
+	if err != nil {
+		panic(fmt.Sprintf("invalid default regions configuration: %s", err))
+	}
+	return cfg
+}
+
+// Default returns the regions configuration compiled into the broker
+func Default() Config {
+	return mustParseDefault()
+}
+
+// NewConfigFromFile reads the regions configuration. Hyperscalers not present in the file keep the default configuration.
+func NewConfigFromFile(path string) (Config, error) {
+	yamlFile, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("while reading YAML file with regions: %w", err)
+	}
+	return parse(yamlFile, Default())
+}
+
+func parse(content []byte, defaults Config) (Config, error) {
+	var cfg Config
+	if err := yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return Config{}, fmt.Errorf("while unmarshaling YAML file with regions: %w", err)
+	}
+	for hyperscaler, regions := range defaults.Regions {
+		if _, found := cfg.Regions[hyperscaler]; !found {
+			if cfg.Regions == nil {
+				cfg.Regions = map[string][]Region{}
+			}
+			cfg.Regions[hyperscaler] = regions
+		}
+	}
+	for machineSet, machineTypes := range defaults.MachineTypes {
+		if _, found := cfg.MachineTypes[machineSet]; !found {
+			if cfg.MachineTypes == nil {
+				cfg.MachineTypes = map[string][]MachineType{}
+			}
+			cfg.MachineTypes[machineSet] = machineTypes
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("while validating regions: %w", err)
+	}
+	return cfg, nil
+}
+
+func (c Config) Validate() error {
+	for hyperscaler, regions := range c.Regions {
+		names := map[string]struct{}{}
+		offered := 0
+		for _, region := range regions {
+			if region.Name == "" {
+				return fmt.Errorf("%s: region name must not be empty", hyperscaler)
+			}
+			if _, exists := names[region.Name]; exists {
+				return fmt.Errorf("%s: duplicated region %s", hyperscaler, region.Name)
+			}
+			names[region.Name] = struct{}{}
+			if region.RestrictedOnly && !region.EUAccess && !region.AssuredWorkloads {
+				return fmt.Errorf("%s: region %s is offered only for restricted platform regions, but is not marked with euAccess nor assuredWorkloads", hyperscaler, region.Name)
+			}
+			for _, zone := range region.Zones {
+				if zone == "" {
+					return fmt.Errorf("%s: region %s contains an empty zone", hyperscaler, region.Name)
+				}
+			}
+			if !region.RestrictedOnly {
+				offered++
+			}
+		}
+		if offered == 0 {
+			return fmt.Errorf("%s: at least one region must be offered for platform regions without restrictions", hyperscaler)
+		}
+	}
+	for machineSet, machineTypes := range c.MachineTypes {
+		if len(machineTypes) == 0 {
+			return fmt.Errorf("%s: machine types must not be empty", machineSet)
+		}
+		names := map[string]struct{}{}
+		for _, machineType := range machineTypes {
+			if machineType.Name == "" {
+				return fmt.Errorf("%s: machine type name must not be empty", machineSet)
+			}
+			if _, exists := names[machineType.Name]; exists {
+				return fmt.Errorf("%s: duplicated machine type %s", machineSet, machineType.Name)
+			}
+			names[machineType.Name] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// Set validates the configuration and replaces the current one
+func Set(cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	current = cfg
+	return nil
+}
+
+// Names returns regions offered for the hyperscaler, the euAccess and assuredWorkloads flags describe restrictions of the platform region
+func Names(hyperscaler string, euAccess, assuredWorkloads bool) []string {
+	var names []string
+	for _, region := range offered(hyperscaler, euAccess, assuredWorkloads) {
+		names = append(names, region.Name)
+	}
+	return names
+}
+
+// DisplayNames returns display names of regions offered for the hyperscaler, nil if no region has a display name
+func DisplayNames(hyperscaler string, euAccess, assuredWorkloads bool) map[string]string {
+	var displayNames map[string]string
+	for _, region := range offered(hyperscaler, euAccess, assuredWorkloads) {
+		if region.DisplayName == "" {
+			continue
+		}
+		if displayNames == nil {
+			displayNames = map[string]string{}
+		}
+		displayNames[region.Name] = region.DisplayName
+	}
+	return displayNames
+}
+
+// Zones returns zone suffixes defined for the region
+func Zones(hyperscaler, region string) ([]string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, r := range current.Regions[hyperscaler] {
+		if r.Name == region && len(r.Zones) > 0 {
+			return append([]string{}, r.Zones...), true
+		}
+	}
+	return nil, false
+}
+
+func MachineTypes(machineSet string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var names []string
+	for _, machineType := range current.MachineTypes[machineSet] {
+		names = append(names, machineType.Name)
+	}
+	return names
+}
+
+func MachineTypesDisplay(machineSet string) map[string]string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	displayNames := map[string]string{}
+	for _, machineType := range current.MachineTypes[machineSet] {
+		displayNames[machineType.Name] = machineType.DisplayName
+		if machineType.DisplayName == "" {
+			displayNames[machineType.Name] = machineType.Name
+		}
+	}
+	return displayNames
+}
+
+func offered(hyperscaler string, euAccess, assuredWorkloads bool) []Region {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var regions []Region
+	for _, region := range current.Regions[hyperscaler] {
+		switch {
+		case euAccess:
+			if region.EUAccess {
+				regions = append(regions, region)
+			}
+		case assuredWorkloads:
+			if region.AssuredWorkloads {
+				regions = append(regions, region)
+			}
+		case !region.RestrictedOnly:
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// Reload reads the regions configuration from the file and replaces the current one.
+// An invalid file is rejected and the previous configuration stays in use.
+func Reload(path string) error {
+	cfg, err := NewConfigFromFile(path)
+	if err != nil {
+		return err
+	}
+	return Set(cfg)
+}
@@ -0,0 +1,117 @@
+package regions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	// when
+	cfg := Default()
+
+	// then
+	for _, hyperscaler := range []string{AWS, Azure, GCP, SapConvergedCloud} {
+		assert.NotEmpty(t, cfg.Regions[hyperscaler], hyperscaler)
+	}
+	for _, machineSet := range []string{AWS, Azure, AzureLite, GCP, SapConvergedCloud} {
+		assert.NotEmpty(t, cfg.MachineTypes[machineSet], machineSet)
+	}
+}
+
+func TestNames(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, Set(Default())) })
+
+	assert.Equal(t, []string{"switzerlandnorth"}, Names(Azure, true, false))
+	assert.NotContains(t, Names(Azure, false, false), "switzerlandnorth")
+	assert.Equal(t, []string{"me-central2"}, Names(GCP, false, true))
+	assert.Contains(t, Names(GCP, false, false), "me-central2")
+	assert.Equal(t, "switzerlandnorth (Switzerland, Zurich)", DisplayNames(Azure, true, false)["switzerlandnorth"])
+	assert.Nil(t, DisplayNames(AWS, false, false))
+}
+
+func TestNewConfigFromFile(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, Set(Default())) })
+
+	// when
+	cfg, err := NewConfigFromFile("testdata/regions.yaml")
+	require.NoError(t, err)
+	err = Set(cfg)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu-central-1", "eu-north-1"}, Names(AWS, false, false))
+	assert.Equal(t, []string{"eu-central-1"}, Names(AWS, true, false))
+	zones, found := Zones(AWS, "eu-north-1")
+	assert.True(t, found)
+	assert.Equal(t, []string{"a", "b"}, zones)
+	assert.Equal(t, []string{"m6i.large", "m7i.large"}, MachineTypes(AWS))
+	assert.Equal(t, map[string]string{"m6i.large": "m6i.large (2vCPU, 8GB RAM)", "m7i.large": "m7i.large"}, MachineTypesDisplay(AWS))
+
+	// hyperscalers not present in the file keep the defaults
+	assert.Equal(t, Default().Regions[Azure], cfg.Regions[Azure])
+	assert.Equal(t, Default().MachineTypes[AzureLite], cfg.MachineTypes[AzureLite])
+}
+
+func TestConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg           Config
+		expectedError string
+	}{
+		"duplicated region": {
+			cfg:           Config{Regions: map[string][]Region{AWS: {{Name: "eu-central-1"}, {Name: "eu-central-1"}}}},
+			expectedError: "aws: duplicated region eu-central-1",
+		},
+		"restricted only region without restriction": {
+			cfg:           Config{Regions: map[string][]Region{AWS: {{Name: "eu-central-1"}, {Name: "eu-west-1", RestrictedOnly: true}}}},
+			expectedError: "aws: region eu-west-1 is offered only for restricted platform regions, but is not marked with euAccess nor assuredWorkloads",
+		},
+		"no region offered": {
+			cfg:           Config{Regions: map[string][]Region{Azure: {{Name: "switzerlandnorth", EUAccess: true, RestrictedOnly: true}}}},
+			expectedError: "azure: at least one region must be offered for platform regions without restrictions",
+		},
+		"empty machine types": {
+			cfg:           Config{MachineTypes: map[string][]MachineType{GCP: {}}},
+			expectedError: "gcp: machine types must not be empty",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, tc.cfg.Validate(), tc.expectedError)
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	// given
+	t.Cleanup(func() { require.NoError(t, Set(Default())) })
+	path := filepath.Join(t.TempDir(), "regions.yaml")
+
+	t.Run("should reject invalid file", func(t *testing.T) {
+		// given
+		require.NoError(t, os.WriteFile(path, []byte("regions:\n  aws: []\n"), 0644))
+
+		// when
+		err := Reload(path)
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, Default().Regions[AWS][0].Name, Names(AWS, false, false)[0])
+	})
+
+	t.Run("should reload changed file", func(t *testing.T) {
+		// given
+		content, err := os.ReadFile("testdata/regions.yaml")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, content, 0644))
+
+		// when
+		err = Reload(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, Names(AWS, false, false), 2)
+	})
+}
@@ -12,6 +12,7 @@ type Converter interface {
 	ApplyProvisioningOperation(dto *pkg.RuntimeDTO, pOpr *internal.ProvisioningOperation)
 	ApplyDeprovisioningOperation(dto *pkg.RuntimeDTO, dOpr *internal.DeprovisioningOperation)
 	ApplyUpgradingClusterOperations(dto *pkg.RuntimeDTO, oprs []internal.UpgradeClusterOperation, totalCount int)
+	ApplyUpgradingKymaOperations(dto *pkg.RuntimeDTO, oprs []internal.Operation, totalCount int)
 	ApplyUpdateOperations(dto *pkg.RuntimeDTO, oprs []internal.UpdatingOperation, totalCount int)
 	ApplySuspensionOperations(dto *pkg.RuntimeDTO, oprs []internal.DeprovisioningOperation)
 	ApplyUnsuspensionOperations(dto *pkg.RuntimeDTO, oprs []internal.ProvisioningOperation)
@@ -91,6 +92,9 @@ func (c *converter) NewDTO(instance internal.Instance) (pkg.RuntimeDTO, error) {
 	if !instance.DeletedAt.IsZero() {
 		toReturn.Status.DeletedAt = &instance.DeletedAt
 	}
+	if instance.Parameters.ErsContext.LicenseType != nil {
+		toReturn.LicenseType = *instance.Parameters.ErsContext.LicenseType
+	}
 
 	c.setRegionOrDefault(instance, &toReturn)
 
@@ -113,6 +117,22 @@ func (c *converter) ApplyUpgradingClusterOperations(dto *pkg.RuntimeDTO, oprs []
 	c.adjustRuntimeState(dto)
 }
 
+func (c *converter) ApplyUpgradingKymaOperations(dto *pkg.RuntimeDTO, oprs []internal.Operation, totalCount int) {
+	if len(oprs) <= 0 {
+		return
+	}
+	dto.Status.UpgradingKyma = &pkg.OperationsData{}
+	dto.Status.UpgradingKyma.Data = make([]pkg.Operation, 0)
+	for _, o := range oprs {
+		op := pkg.Operation{}
+		c.applyOperation(&o, &op)
+		dto.Status.UpgradingKyma.Data = append(dto.Status.UpgradingKyma.Data, op)
+	}
+	dto.Status.UpgradingKyma.TotalCount = totalCount
+	dto.Status.UpgradingKyma.Count = len(dto.Status.UpgradingKyma.Data)
+	c.adjustRuntimeState(dto)
+}
+
 func (c *converter) ApplySuspensionOperations(dto *pkg.RuntimeDTO, oprs []internal.DeprovisioningOperation) {
 	if len(oprs) <= 0 {
 		return
@@ -189,7 +209,7 @@ func (c *converter) adjustRuntimeState(dto *pkg.RuntimeDTO) {
 	case string(domain.Failed):
 		dto.Status.State = pkg.StateFailed
 		switch lastOp.Type {
-		case pkg.UpgradeCluster, pkg.Update:
+		case pkg.UpgradeCluster, pkg.UpgradeKyma, pkg.Update:
 			dto.Status.State = pkg.StateError
 		}
 	case string(domain.InProgress):
@@ -198,7 +218,7 @@ func (c *converter) adjustRuntimeState(dto *pkg.RuntimeDTO) {
 			dto.Status.State = pkg.StateProvisioning
 		case pkg.Deprovision, pkg.Suspension:
 			dto.Status.State = pkg.StateDeprovisioning
-		case pkg.UpgradeCluster:
+		case pkg.UpgradeCluster, pkg.UpgradeKyma:
 			dto.Status.State = pkg.StateUpgrading
 		case pkg.Update:
 			dto.Status.State = pkg.StateUpdating
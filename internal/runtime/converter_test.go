@@ -91,6 +91,25 @@ func TestConverting_UpdateFailed(t *testing.T) {
 	assert.Equal(t, runtime.StateError, dto.Status.State)
 }
 
+func TestConverting_UpgradingKyma(t *testing.T) {
+	// given
+	instance := fixInstance()
+	svc := NewConverter("eu")
+
+	// when
+	dto, _ := svc.NewDTO(instance)
+	svc.ApplyProvisioningOperation(&dto, fixProvisioningOperation(domain.Succeeded, time.Now()))
+	svc.ApplyUpgradingKymaOperations(&dto, []internal.Operation{{
+		CreatedAt: time.Now().Add(time.Second),
+		ID:        "upgrade-id",
+		State:     domain.InProgress,
+	}}, 1)
+
+	// then
+	assert.Equal(t, runtime.StateUpgrading, dto.Status.State)
+	assert.Equal(t, runtime.UpgradeKyma, dto.LastOperation().Type)
+}
+
 func TestConverting_Suspending(t *testing.T) {
 	t.Run("last operation should be deprovisioning", func(t *testing.T) {
 		// given
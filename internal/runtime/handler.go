@@ -38,6 +38,7 @@ type Handler struct {
 	operationsDb        storage.Operations
 	runtimeStatesDb     storage.RuntimeStates
 	instancesArchivedDb storage.InstancesArchived
+	bindingsDb          storage.Bindings
 	converter           Converter
 	defaultMaxPage      int
 	provisionerClient   provisioner.Client
@@ -47,7 +48,7 @@ type Handler struct {
 }
 
 func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, runtimeStatesDb storage.RuntimeStates,
-	instancesArchived storage.InstancesArchived, defaultMaxPage int, defaultRequestRegion string,
+	instancesArchived storage.InstancesArchived, bindingsDb storage.Bindings, defaultMaxPage int, defaultRequestRegion string,
 	provisionerClient provisioner.Client,
 	k8sClient client.Client, kimConfig broker.KimConfig,
 	logger logrus.FieldLogger) *Handler {
@@ -59,6 +60,7 @@ func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, ru
 		defaultMaxPage:      defaultMaxPage,
 		provisionerClient:   provisionerClient,
 		instancesArchivedDb: instancesArchived,
+		bindingsDb:          bindingsDb,
 		kimConfig:           kimConfig,
 		k8sClient:           k8sClient,
 		logger:              logger.WithField("service", "RuntimeHandler"),
@@ -67,6 +69,7 @@ func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, ru
 
 func (h *Handler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/runtimes", h.getRuntimes)
+	router.HandleFunc("/runtimes/{instance_id}/bindings", h.getBindings).Methods(http.MethodGet)
 }
 
 func unionInstances(sets ...[]pkg.RuntimeDTO) (union []pkg.RuntimeDTO) {
@@ -272,6 +275,51 @@ func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 	httputil.WriteResponse(w, http.StatusOK, runtimePage)
 }
 
+func (h *Handler) getBindings(w http.ResponseWriter, req *http.Request) {
+	instanceID := mux.Vars(req)["instance_id"]
+
+	_, err := h.instancesDb.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("instance %s does not exist", instanceID))
+		return
+	case err != nil:
+		h.logger.Warn(fmt.Sprintf("unable to fetch instance %s: %s", instanceID, err.Error()))
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while fetching instance: %s", err.Error()))
+		return
+	}
+
+	bindings, err := h.bindingsDb.ListByInstanceID(instanceID)
+	if err != nil {
+		h.logger.Warn(fmt.Sprintf("unable to fetch bindings for instance %s: %s", instanceID, err.Error()))
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while fetching bindings: %s", err.Error()))
+		return
+	}
+
+	// credentials are never returned by this endpoint
+	toReturn := make([]pkg.BindingDTO, 0, len(bindings))
+	for _, binding := range bindings {
+		toReturn = append(toReturn, pkg.BindingDTO{
+			ID:             binding.ID,
+			Type:           binding.BindingType,
+			Role:           binding.Role,
+			Namespace:      binding.Namespace,
+			CreatedAt:      binding.CreatedAt,
+			ExpiresAt:      binding.ExpiresAt,
+			OperationType:  string(binding.OperationType),
+			OperationState: string(binding.OperationState),
+		})
+	}
+	slices.SortFunc(toReturn, func(a, b pkg.BindingDTO) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	httputil.WriteResponse(w, http.StatusOK, pkg.BindingsPage{
+		Data:  toReturn,
+		Count: len(toReturn),
+	})
+}
+
 func (h *Handler) getRuntimeNamesFromLastOperation(dto pkg.RuntimeDTO) (string, string) {
 	// TODO get rid of additional DB query - we have this info fetched from DB but it is tedious to pass it through
 	op, err := h.operationsDb.GetLastOperation(dto.InstanceID)
@@ -409,6 +457,9 @@ func (h *Handler) setRuntimeLastOperation(dto *pkg.RuntimeDTO) error {
 		}
 		h.converter.ApplyUpdateOperations(dto, []internal.UpdatingOperation{*updOp}, 1)
 
+	case internal.OperationTypeUpgradeKyma:
+		h.converter.ApplyUpgradingKymaOperations(dto, []internal.Operation{*lastOp}, 1)
+
 	default:
 		return fmt.Errorf("unsupported operation type: %s", lastOp.Type)
 	}
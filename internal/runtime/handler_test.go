@@ -65,7 +65,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = instances.Insert(testInstance2)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes?page_size=1", nil)
 		require.NoError(t, err)
@@ -120,7 +120,7 @@ func TestRuntimeHandler(t *testing.T) {
 		states := db.RuntimeStates()
 		archived := db.InstancesArchived()
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "region", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "region", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes?page_size=a", nil)
 		require.NoError(t, err)
@@ -179,7 +179,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertOperation(testOp2)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("/runtimes?account=%s&subaccount=%s&instance_id=%s&runtime_id=%s&region=%s&shoot=%s", testID1, testID1, testID1, testID1, testID1, fmt.Sprintf("Shoot-%s", testID1)), nil)
 		require.NoError(t, err)
@@ -255,7 +255,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertDeprovisioningOperation(deprovOp3)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -364,7 +364,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -437,7 +437,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -509,7 +509,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -564,7 +564,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertUpdatingOperation(updOp)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -695,7 +695,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = states.Insert(fixOpgClusterState)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -749,7 +749,7 @@ func TestRuntimeHandler(t *testing.T) {
 		_, err = provisionerClient.ProvisionRuntimeWithIDs(operation.GlobalAccountID, operation.SubAccountID, operation.RuntimeID, operation.ID, input)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -801,7 +801,7 @@ func TestRuntimeHandler(t *testing.T) {
 		_, err = provisionerClient.ProvisionRuntimeWithIDs(operation.GlobalAccountID, operation.SubAccountID, operation.RuntimeID, operation.ID, input)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -862,7 +862,7 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 		err = operations.InsertUpdatingOperation(updOp)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -992,7 +992,7 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 		err = states.Insert(fixOpgClusterState)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -1046,7 +1046,7 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 		_, err = provisionerClient.ProvisionRuntimeWithIDs(operation.GlobalAccountID, operation.SubAccountID, operation.RuntimeID, operation.ID, input)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -1104,7 +1104,7 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 			KimOnlyPlans: []string{"no-plan"},
 		}
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimDisabledForPreview, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimDisabledForPreview, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -1158,7 +1158,7 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 		_, err = provisionerClient.ProvisionRuntimeWithIDs(operation.GlobalAccountID, operation.SubAccountID, operation.RuntimeID, operation.ID, input)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
+		runtimeHandler := runtime.NewHandler(instances, operations, states, archived, db.Bindings(), 2, "", provisionerClient, k8sClient, kimConfig, logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -1202,6 +1202,78 @@ func TestRuntimeHandler_WithKimOnlyDrivenInstances(t *testing.T) {
 
 }
 
+func TestRuntimeHandler_GetBindings(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().Build()
+	kimConfig := broker.KimConfig{
+		Enabled: false,
+	}
+
+	t.Run("should return bindings without credentials", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		err := db.Instances().Insert(fixInstance("instance-id", time.Now()))
+		require.NoError(t, err)
+
+		first := fixture.FixBindingWithInstanceID("first", "instance-id")
+		first.CreatedAt = time.Now().Add(-time.Hour)
+		err = db.Bindings().Insert(&first)
+		require.NoError(t, err)
+		second := fixture.FixBindingWithInstanceID("second", "instance-id")
+		second.Role = internal.BINDING_ROLE_NAMESPACE_ADMIN
+		second.Namespace = "ci"
+		err = db.Bindings().Insert(&second)
+		require.NoError(t, err)
+		other := fixture.FixBindingWithInstanceID("other", "other-instance-id")
+		err = db.Bindings().Insert(&other)
+		require.NoError(t, err)
+
+		runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), db.InstancesArchived(), db.Bindings(), 2, "", provisioner.NewFakeClient(), k8sClient, kimConfig, logrus.New())
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		req, err := http.NewRequest(http.MethodGet, "/runtimes/instance-id/bindings", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), first.Kubeconfig)
+
+		var out pkg.BindingsPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, out.Count)
+		assert.Equal(t, "first", out.Data[0].ID)
+		assert.Equal(t, internal.BINDING_TYPE_SERVICE_ACCOUNT, out.Data[0].Type)
+		assert.Equal(t, "second", out.Data[1].ID)
+		assert.Equal(t, internal.BINDING_ROLE_NAMESPACE_ADMIN, out.Data[1].Role)
+		assert.Equal(t, "ci", out.Data[1].Namespace)
+		assert.WithinDuration(t, second.ExpiresAt, out.Data[1].ExpiresAt, time.Second)
+	})
+
+	t.Run("should return not found for not existing instance", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), db.InstancesArchived(), db.Bindings(), 2, "", provisioner.NewFakeClient(), k8sClient, kimConfig, logrus.New())
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		req, err := http.NewRequest(http.MethodGet, "/runtimes/instance-id/bindings", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
 func fixInstance(id string, t time.Time) internal.Instance {
 	return internal.Instance{
 		InstanceID:      id,
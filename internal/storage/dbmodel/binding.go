@@ -9,9 +9,17 @@ type BindingDTO struct {
 	InstanceID string
 
 	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
 
 	Kubeconfig        string
 	ExpirationSeconds int64
 	GenerationMethod  string
 	BindingType       string
+	Role              string
+	Namespace         string
+
+	OperationType        string
+	OperationState       string
+	OperationDescription string
 }
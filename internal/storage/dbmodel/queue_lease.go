@@ -0,0 +1,8 @@
+package dbmodel
+
+type QueueLeaseDTO struct {
+	QueueName  string
+	ItemID     string
+	LeaseOwner string
+	Generation int64
+}
@@ -2,9 +2,11 @@ package memory
 
 import (
 	"sync"
+	"time"
 
 	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 )
 
 type Binding struct {
@@ -40,6 +42,18 @@ func (s *Binding) Insert(binding *internal.Binding) error {
 	return nil
 }
 
+func (s *Binding) Update(binding *internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.data[binding.ID]; !found {
+		return dberr.NotFound("binding with id %s not exist", binding.ID)
+	}
+	s.data[binding.ID] = *binding
+
+	return nil
+}
+
 func (s *Binding) DeleteByBindingID(bindingID string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -61,3 +75,32 @@ func (s *Binding) ListByInstanceID(instanceID string) ([]internal.Binding, error
 
 	return bindings, nil
 }
+
+func (s *Binding) ListByOperationState(state domain.LastOperationState) ([]internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var bindings []internal.Binding
+	for _, binding := range s.data {
+		if binding.OperationState == state {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings, nil
+}
+
+func (s *Binding) ListExpired() ([]internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var bindings []internal.Binding
+	now := time.Now()
+	for _, binding := range s.data {
+		if binding.ExpiresAt.Before(now) {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings, nil
+}
@@ -214,6 +214,9 @@ func (s *operations) ListOperationsByInstanceIDGroupByType(instanceID string) (*
 
 		case internal.OperationTypeUpdate:
 			grouped.UpdateOperations = append(grouped.UpdateOperations, internal.UpdatingOperation{Operation: op})
+
+		case internal.OperationTypeUpgradeKyma:
+			continue
 		default:
 			panic("Invalid type of operation")
 		}
@@ -638,6 +641,14 @@ func (s *operations) GetOperationStatsForOrchestration(orchestrationID string) (
 			opStatePerInstanceID[string(op.Operation.InstanceID)] = append(opStatePerInstanceID[string(op.Operation.InstanceID)], string(op.State))
 		}
 	}
+	for _, op := range s.operations {
+		if op.OrchestrationID == orchestrationID && op.Type == internal.OperationTypeUpgradeKyma {
+			if op.State != Failed {
+				result[string(op.State)] = result[string(op.State)] + 1
+			}
+			opStatePerInstanceID[op.InstanceID] = append(opStatePerInstanceID[op.InstanceID], string(op.State))
+		}
+	}
 
 	_, failedum := s.calFailedStatusForOrchestration(opStatePerInstanceID)
 	result[Failed] = failedum
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+)
+
+type queueItem struct {
+	queueName      string
+	itemID         string
+	generation     int64
+	notBefore      time.Time
+	leaseOwner     string
+	leaseExpiresAt time.Time
+}
+
+type QueueLeases struct {
+	mu    sync.Mutex
+	items map[string]*queueItem
+}
+
+func NewQueueLeases() *QueueLeases {
+	return &QueueLeases{
+		items: make(map[string]*queueItem),
+	}
+}
+
+func (s *QueueLeases) Add(queueName, itemID string, delay time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, found := s.items[s.key(queueName, itemID)]
+	if !found {
+		item = &queueItem{queueName: queueName, itemID: itemID}
+		s.items[s.key(queueName, itemID)] = item
+	}
+	item.generation++
+	item.notBefore = time.Now().Add(delay)
+	return nil
+}
+
+func (s *QueueLeases) Claim(queueName, owner string, leaseDuration time.Duration) (internal.QueueLease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var ready []*queueItem
+	for _, item := range s.items {
+		if item.queueName == queueName && !item.notBefore.After(now) && (item.leaseOwner == "" || item.leaseExpiresAt.Before(now)) {
+			ready = append(ready, item)
+		}
+	}
+	if len(ready) == 0 {
+		return internal.QueueLease{}, dberr.NotFound("no items ready to be processed in the queue %s", queueName)
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].notBefore.Before(ready[j].notBefore) })
+
+	item := ready[0]
+	item.leaseOwner = owner
+	item.leaseExpiresAt = now.Add(leaseDuration)
+	return internal.QueueLease{QueueName: queueName, ItemID: item.itemID, Owner: owner, Generation: item.generation}, nil
+}
+
+func (s *QueueLeases) Renew(lease internal.QueueLease, leaseDuration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, found := s.items[s.key(lease.QueueName, lease.ItemID)]
+	if !found || item.leaseOwner != lease.Owner {
+		return dberr.NotFound("lease of the item %s in the queue %s not found", lease.ItemID, lease.QueueName)
+	}
+	item.leaseExpiresAt = time.Now().Add(leaseDuration)
+	return nil
+}
+
+func (s *QueueLeases) Release(lease internal.QueueLease) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.release(lease)
+	return nil
+}
+
+func (s *QueueLeases) Remove(lease internal.QueueLease) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, found := s.items[s.key(lease.QueueName, lease.ItemID)]
+	if found && item.leaseOwner == lease.Owner && item.generation == lease.Generation {
+		delete(s.items, s.key(lease.QueueName, lease.ItemID))
+		return nil
+	}
+	s.release(lease)
+	return nil
+}
+
+func (s *QueueLeases) Count(queueName string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, item := range s.items {
+		if item.queueName == queueName {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *QueueLeases) release(lease internal.QueueLease) {
+	item, found := s.items[s.key(lease.QueueName, lease.ItemID)]
+	if found && item.leaseOwner == lease.Owner {
+		item.leaseOwner = ""
+		item.leaseExpiresAt = time.Time{}
+	}
+}
+
+func (s *QueueLeases) key(queueName, itemID string) string {
+	return queueName + "/" + itemID
+}
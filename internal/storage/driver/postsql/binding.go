@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/postsql"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -83,6 +84,55 @@ func (s *Binding) ListByInstanceID(instanceID string) ([]internal.Binding, error
 	return bindings, err
 }
 
+func (s *Binding) ListByOperationState(state domain.LastOperationState) ([]internal.Binding, error) {
+	dtos, err := s.NewReadSession().ListBindingsByOperationState(string(state))
+	if err != nil {
+		return []internal.Binding{}, err
+	}
+	var bindings []internal.Binding
+	for _, dto := range dtos {
+		binding, err := s.toBinding(dto)
+		if err != nil {
+			return []internal.Binding{}, err
+		}
+
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
+
+func (s *Binding) ListExpired() ([]internal.Binding, error) {
+	dtos, err := s.NewReadSession().ListExpiredBindings()
+	if err != nil {
+		return []internal.Binding{}, err
+	}
+	var bindings []internal.Binding
+	for _, dto := range dtos {
+		binding, err := s.toBinding(dto)
+		if err != nil {
+			return []internal.Binding{}, err
+		}
+
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
+
+func (s *Binding) Update(binding *internal.Binding) error {
+	dto, err := s.toBindingDTO(binding)
+	if err != nil {
+		return err
+	}
+
+	sess := s.NewWriteSession()
+	err = sess.UpdateBinding(dto)
+	if err != nil {
+		return fmt.Errorf("while updating binding with ID %s: %w", binding.ID, err)
+	}
+
+	return nil
+}
+
 func (s *Binding) toBindingDTO(binding *internal.Binding) (dbmodel.BindingDTO, error) {
 	encrypted, err := s.cipher.Encrypt([]byte(binding.Kubeconfig))
 	if err != nil {
@@ -94,7 +144,16 @@ func (s *Binding) toBindingDTO(binding *internal.Binding) (dbmodel.BindingDTO, e
 		ID:                binding.ID,
 		InstanceID:        binding.InstanceID,
 		CreatedAt:         binding.CreatedAt,
+		UpdatedAt:         binding.UpdatedAt,
+		ExpiresAt:         binding.ExpiresAt,
 		ExpirationSeconds: binding.ExpirationSeconds,
+		BindingType:       binding.BindingType,
+		Role:              binding.Role,
+		Namespace:         binding.Namespace,
+
+		OperationType:        string(binding.OperationType),
+		OperationState:       string(binding.OperationState),
+		OperationDescription: binding.OperationDescription,
 	}, nil
 }
 
@@ -109,6 +168,15 @@ func (s *Binding) toBinding(dto dbmodel.BindingDTO) (internal.Binding, error) {
 		ID:                dto.ID,
 		InstanceID:        dto.InstanceID,
 		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+		ExpiresAt:         dto.ExpiresAt,
 		ExpirationSeconds: dto.ExpirationSeconds,
+		BindingType:       dto.BindingType,
+		Role:              dto.Role,
+		Namespace:         dto.Namespace,
+
+		OperationType:        internal.BindingOperationType(dto.OperationType),
+		OperationState:       domain.LastOperationState(dto.OperationState),
+		OperationDescription: dto.OperationDescription,
 	}, nil
 }
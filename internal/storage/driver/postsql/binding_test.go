@@ -2,6 +2,7 @@ package postsql_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kyma-project/kyma-environment-broker/internal/fixture"
@@ -219,4 +220,33 @@ func TestBinding(t *testing.T) {
 			assert.Equal(t, sameInstanceID, binding.InstanceID)
 		}
 	})
+
+	t.Run("should list only expired bindings", func(t *testing.T) {
+		storageCleanup, brokerStorage, err := GetStorageForDatabaseTests()
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+		defer func() {
+			err := storageCleanup()
+			assert.NoError(t, err)
+		}()
+
+		// given
+		expiredBinding := fixture.FixBinding("expired")
+		expiredBinding.ExpiresAt = time.Now().Add(-time.Minute)
+		err = brokerStorage.Bindings().Insert(&expiredBinding)
+		assert.NoError(t, err)
+
+		validBinding := fixture.FixBinding("valid")
+		validBinding.ExpiresAt = time.Now().Add(time.Minute)
+		err = brokerStorage.Bindings().Insert(&validBinding)
+		assert.NoError(t, err)
+
+		// when
+		bindings, err := brokerStorage.Bindings().ListExpired()
+
+		// then
+		assert.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "expired", bindings[0].ID)
+	})
 }
@@ -0,0 +1,72 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/postsql"
+)
+
+type QueueLeases struct {
+	postsql.Factory
+}
+
+func NewQueueLeases(sess postsql.Factory) *QueueLeases {
+	return &QueueLeases{
+		Factory: sess,
+	}
+}
+
+func (s *QueueLeases) Add(queueName, itemID string, delay time.Duration) error {
+	return s.NewWriteSession().UpsertQueueItem(queueName, itemID, delay)
+}
+
+func (s *QueueLeases) Claim(queueName, owner string, leaseDuration time.Duration) (internal.QueueLease, error) {
+	dto, err := s.NewWriteSession().ClaimQueueItem(queueName, owner, leaseDuration)
+	if err != nil {
+		return internal.QueueLease{}, err
+	}
+	return s.toQueueLease(dto), nil
+}
+
+func (s *QueueLeases) Renew(lease internal.QueueLease, leaseDuration time.Duration) error {
+	return s.NewWriteSession().RenewQueueLease(s.toQueueLeaseDTO(lease), leaseDuration)
+}
+
+func (s *QueueLeases) Release(lease internal.QueueLease) error {
+	return s.NewWriteSession().ReleaseQueueLease(s.toQueueLeaseDTO(lease))
+}
+
+func (s *QueueLeases) Remove(lease internal.QueueLease) error {
+	sess := s.NewWriteSession()
+	err := sess.DeleteQueueItem(s.toQueueLeaseDTO(lease))
+	if dberr.IsNotFound(err) {
+		// the item was added again while processed
+		return sess.ReleaseQueueLease(s.toQueueLeaseDTO(lease))
+	}
+	return err
+}
+
+func (s *QueueLeases) Count(queueName string) (int, error) {
+	return s.NewReadSession().CountQueueItems(queueName)
+}
+
+func (s *QueueLeases) toQueueLease(dto dbmodel.QueueLeaseDTO) internal.QueueLease {
+	return internal.QueueLease{
+		QueueName:  dto.QueueName,
+		ItemID:     dto.ItemID,
+		Owner:      dto.LeaseOwner,
+		Generation: dto.Generation,
+	}
+}
+
+func (s *QueueLeases) toQueueLeaseDTO(lease internal.QueueLease) dbmodel.QueueLeaseDTO {
+	return dbmodel.QueueLeaseDTO{
+		QueueName:  lease.QueueName,
+		ItemID:     lease.ItemID,
+		LeaseOwner: lease.Owner,
+		Generation: lease.Generation,
+	}
+}
@@ -0,0 +1,104 @@
+package postsql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/kyma-environment-broker/internal/storage/dberr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueLeases(t *testing.T) {
+
+	t.Run("should claim every item by one owner only", func(t *testing.T) {
+		storageCleanup, brokerStorage, err := GetStorageForDatabaseTests()
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+		defer func() {
+			err := storageCleanup()
+			assert.NoError(t, err)
+		}()
+
+		// given
+		leases := brokerStorage.QueueLeases()
+		require.NoError(t, leases.Add("provisioning", "op-1", 0))
+		require.NoError(t, leases.Add("provisioning", "op-2", time.Hour))
+		require.NoError(t, leases.Add("deprovisioning", "op-3", 0))
+
+		// when
+		lease, err := leases.Claim("provisioning", "replica-1", time.Minute)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "op-1", lease.ItemID)
+		assert.Equal(t, "replica-1", lease.Owner)
+
+		// when
+		_, err = leases.Claim("provisioning", "replica-2", time.Minute)
+
+		// then
+		assert.True(t, dberr.IsNotFound(err))
+
+		// when
+		err = leases.Remove(lease)
+
+		// then
+		require.NoError(t, err)
+		count, err := leases.Count("provisioning")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should claim item with expired lease", func(t *testing.T) {
+		storageCleanup, brokerStorage, err := GetStorageForDatabaseTests()
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+		defer func() {
+			err := storageCleanup()
+			assert.NoError(t, err)
+		}()
+
+		// given
+		leases := brokerStorage.QueueLeases()
+		require.NoError(t, leases.Add("provisioning", "op-1", 0))
+		expired, err := leases.Claim("provisioning", "replica-1", time.Millisecond)
+		require.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+
+		// when
+		lease, err := leases.Claim("provisioning", "replica-2", time.Minute)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "op-1", lease.ItemID)
+		assert.True(t, dberr.IsNotFound(leases.Renew(expired, time.Minute)))
+	})
+
+	t.Run("should keep item added again while processed", func(t *testing.T) {
+		storageCleanup, brokerStorage, err := GetStorageForDatabaseTests()
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+		defer func() {
+			err := storageCleanup()
+			assert.NoError(t, err)
+		}()
+
+		// given
+		leases := brokerStorage.QueueLeases()
+		require.NoError(t, leases.Add("update", "op-1", 0))
+		lease, err := leases.Claim("update", "replica-1", time.Minute)
+		require.NoError(t, err)
+		require.NoError(t, leases.Add("update", "op-1", 0))
+
+		// when
+		err = leases.Remove(lease)
+
+		// then
+		require.NoError(t, err)
+		again, err := leases.Claim("update", "replica-2", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, "op-1", again.ItemID)
+		assert.Equal(t, lease.Generation+1, again.Generation)
+	})
+}
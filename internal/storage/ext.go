@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/kyma-environment-broker/internal"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/kyma-environment-broker/internal/storage/predicate"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 )
 
 type Instances interface {
@@ -132,5 +133,21 @@ type Bindings interface {
 	Insert(binding *internal.Binding) error
 	GetByBindingID(bindingID string) (*internal.Binding, error)
 	ListByInstanceID(instanceID string) ([]internal.Binding, error)
+	ListByOperationState(state domain.LastOperationState) ([]internal.Binding, error)
+	ListExpired() ([]internal.Binding, error)
+	Update(binding *internal.Binding) error
 	DeleteByBindingID(bindingID string) error
 }
+
+// QueueLeases stores items of the processing queues shared by all broker replicas.
+// An item is claimed by one replica at a time, a claim which is not renewed expires and the item can be claimed by another replica.
+type QueueLeases interface {
+	Add(queueName, itemID string, delay time.Duration) error
+	// Claim returns a lease of an item ready to be processed or dberr.NotFound when there is no such item
+	Claim(queueName, owner string, leaseDuration time.Duration) (internal.QueueLease, error)
+	Renew(lease internal.QueueLease, leaseDuration time.Duration) error
+	Release(lease internal.QueueLease) error
+	// Remove removes the processed item, the item is only released if it was added again while processed
+	Remove(lease internal.QueueLease) error
+	Count(queueName string) (int, error)
+}
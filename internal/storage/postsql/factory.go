@@ -63,6 +63,9 @@ type ReadSession interface {
 	ListInstancesArchived(filter dbmodel.InstanceFilter) ([]dbmodel.InstanceArchivedDTO, int, int, error)
 	GetBindingByID(instanceID string) (dbmodel.BindingDTO, dberr.Error)
 	ListBindings(instanceID string) ([]dbmodel.BindingDTO, error)
+	ListBindingsByOperationState(state string) ([]dbmodel.BindingDTO, error)
+	ListExpiredBindings() ([]dbmodel.BindingDTO, error)
+	CountQueueItems(queueName string) (int, dberr.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -83,7 +86,13 @@ type WriteSession interface {
 	DeleteOperationByID(operationID string) dberr.Error
 	InsertInstanceArchived(instance dbmodel.InstanceArchivedDTO) dberr.Error
 	InsertBinding(binding dbmodel.BindingDTO) dberr.Error
+	UpdateBinding(binding dbmodel.BindingDTO) dberr.Error
 	DeleteBinding(ID string) dberr.Error
+	UpsertQueueItem(queueName, itemID string, delay time.Duration) dberr.Error
+	ClaimQueueItem(queueName, owner string, leaseDuration time.Duration) (dbmodel.QueueLeaseDTO, dberr.Error)
+	RenewQueueLease(lease dbmodel.QueueLeaseDTO, leaseDuration time.Duration) dberr.Error
+	ReleaseQueueLease(lease dbmodel.QueueLeaseDTO) dberr.Error
+	DeleteQueueItem(lease dbmodel.QueueLeaseDTO) dberr.Error
 }
 
 type Transaction interface {
@@ -21,6 +21,7 @@ const (
 	CreatedAtField             = "created_at"
 	InstancesArchivedTableName = "instances_archived"
 	BindingsTableName          = "bindings"
+	QueueItemsTableName        = "queue_items"
 )
 
 // InitializeDatabase opens database connection and initializes schema if it does not exist
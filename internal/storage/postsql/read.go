@@ -54,6 +54,39 @@ func (r readSession) ListBindings(instanceID string) ([]dbmodel.BindingDTO, erro
 	return bindings, err
 }
 
+func (r readSession) ListBindingsByOperationState(state string) ([]dbmodel.BindingDTO, error) {
+	var bindings []dbmodel.BindingDTO
+	_, err := r.session.Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("operation_state", state)).
+		OrderBy("updated_at").
+		Load(&bindings)
+	return bindings, err
+}
+
+func (r readSession) ListExpiredBindings() ([]dbmodel.BindingDTO, error) {
+	var bindings []dbmodel.BindingDTO
+	_, err := r.session.Select("*").
+		From(BindingsTableName).
+		Where(dbr.Lt("expires_at", time.Now())).
+		OrderBy("expires_at").
+		Load(&bindings)
+	return bindings, err
+}
+
+func (r readSession) CountQueueItems(queueName string) (int, dberr.Error) {
+	var count int
+	err := r.session.
+		Select("count(*)").
+		From(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", queueName)).
+		LoadOne(&count)
+	if err != nil {
+		return 0, dberr.Internal("Failed to count items of the %s queue: %s", queueName, err)
+	}
+	return count, nil
+}
+
 func (r readSession) ListSubaccountStates() ([]dbmodel.SubaccountStateDTO, dberr.Error) {
 	var states []dbmodel.SubaccountStateDTO
 
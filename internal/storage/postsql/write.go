@@ -1,6 +1,7 @@
 package postsql
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -37,9 +38,16 @@ func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
 		Pair("id", binding.ID).
 		Pair("instance_id", binding.InstanceID).
 		Pair("created_at", binding.CreatedAt).
+		Pair("expires_at", binding.ExpiresAt).
 		Pair("kubeconfig", binding.Kubeconfig).
 		Pair("expiration_seconds", binding.ExpirationSeconds).
 		Pair("binding_type", binding.BindingType).
+		Pair("role", binding.Role).
+		Pair("namespace", binding.Namespace).
+		Pair("updated_at", binding.UpdatedAt).
+		Pair("operation_type", binding.OperationType).
+		Pair("operation_state", binding.OperationState).
+		Pair("operation_description", binding.OperationDescription).
 		Exec()
 
 	if err != nil {
@@ -54,6 +62,32 @@ func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
 	return nil
 }
 
+func (ws writeSession) UpdateBinding(binding dbmodel.BindingDTO) dberr.Error {
+	res, err := ws.update(BindingsTableName).
+		Where(dbr.Eq("id", binding.ID)).
+		Where(dbr.Eq("instance_id", binding.InstanceID)).
+		Set("updated_at", binding.UpdatedAt).
+		Set("expires_at", binding.ExpiresAt).
+		Set("kubeconfig", binding.Kubeconfig).
+		Set("expiration_seconds", binding.ExpirationSeconds).
+		Set("operation_type", binding.OperationType).
+		Set("operation_state", binding.OperationState).
+		Set("operation_description", binding.OperationDescription).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update record in Binding table: %s", err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find Binding with ID:'%s' for instance ID: '%s'", binding.ID, binding.InstanceID)
+	}
+
+	return nil
+}
+
 func (ws writeSession) InsertInstanceArchived(instance dbmodel.InstanceArchivedDTO) dberr.Error {
 	_, err := ws.insertInto(InstancesArchivedTableName).
 		Pair("instance_id", instance.InstanceID).
@@ -398,6 +432,93 @@ func (ws writeSession) RollbackUnlessCommitted() {
 	ws.transaction.RollbackUnlessCommitted()
 }
 
+func (ws writeSession) UpsertQueueItem(queueName, itemID string, delay time.Duration) dberr.Error {
+	_, err := ws.updateBySql(fmt.Sprintf(`INSERT INTO %s (queue_name, item_id, generation, not_before, created_at)
+		VALUES (?, ?, 1, now() + ? * interval '1 millisecond', now())
+		ON CONFLICT (queue_name, item_id) DO UPDATE SET generation = %s.generation + 1, not_before = EXCLUDED.not_before`, QueueItemsTableName, QueueItemsTableName),
+		queueName, itemID, delay.Milliseconds()).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to upsert item %s to the %s queue: %s", itemID, queueName, err)
+	}
+	return nil
+}
+
+// ClaimQueueItem leases the item with the earliest due time, items leased by other replicas are skipped without waiting
+func (ws writeSession) ClaimQueueItem(queueName, owner string, leaseDuration time.Duration) (dbmodel.QueueLeaseDTO, dberr.Error) {
+	var lease dbmodel.QueueLeaseDTO
+	err := ws.selectBySql(fmt.Sprintf(`UPDATE %s SET lease_owner = ?, lease_expires_at = now() + ? * interval '1 millisecond'
+		WHERE queue_name = ? AND item_id = (
+			SELECT item_id FROM %s
+			WHERE queue_name = ? AND not_before <= now() AND (lease_expires_at IS NULL OR lease_expires_at < now())
+			ORDER BY not_before
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING queue_name, item_id, lease_owner, generation`, QueueItemsTableName, QueueItemsTableName),
+		owner, leaseDuration.Milliseconds(), queueName, queueName).
+		LoadOne(&lease)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.QueueLeaseDTO{}, dberr.NotFound("no items ready to be processed in the %s queue", queueName)
+		}
+		return dbmodel.QueueLeaseDTO{}, dberr.Internal("Failed to claim an item from the %s queue: %s", queueName, err)
+	}
+	return lease, nil
+}
+
+func (ws writeSession) RenewQueueLease(lease dbmodel.QueueLeaseDTO, leaseDuration time.Duration) dberr.Error {
+	res, err := ws.updateBySql(fmt.Sprintf(`UPDATE %s SET lease_expires_at = now() + ? * interval '1 millisecond'
+		WHERE queue_name = ? AND item_id = ? AND lease_owner = ?`, QueueItemsTableName),
+		leaseDuration.Milliseconds(), lease.QueueName, lease.ItemID, lease.LeaseOwner).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to renew lease of the item %s in the %s queue: %s", lease.ItemID, lease.QueueName, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to get number of renewed leases: %s", err)
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("lease of the item %s in the %s queue not found", lease.ItemID, lease.QueueName)
+	}
+	return nil
+}
+
+func (ws writeSession) ReleaseQueueLease(lease dbmodel.QueueLeaseDTO) dberr.Error {
+	_, err := ws.update(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", lease.QueueName)).
+		Where(dbr.Eq("item_id", lease.ItemID)).
+		Where(dbr.Eq("lease_owner", lease.LeaseOwner)).
+		Set("lease_owner", nil).
+		Set("lease_expires_at", nil).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to release lease of the item %s in the %s queue: %s", lease.ItemID, lease.QueueName, err)
+	}
+	return nil
+}
+
+// DeleteQueueItem deletes the leased item unless it was added again since it was claimed
+func (ws writeSession) DeleteQueueItem(lease dbmodel.QueueLeaseDTO) dberr.Error {
+	res, err := ws.deleteFrom(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", lease.QueueName)).
+		Where(dbr.Eq("item_id", lease.ItemID)).
+		Where(dbr.Eq("lease_owner", lease.LeaseOwner)).
+		Where(dbr.Eq("generation", lease.Generation)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete item %s from the %s queue: %s", lease.ItemID, lease.QueueName, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to get number of deleted queue items: %s", err)
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("leased item %s of generation %d not found in the %s queue", lease.ItemID, lease.Generation, lease.QueueName)
+	}
+	return nil
+}
+
 func (ws writeSession) insertInto(table string) *dbr.InsertStmt {
 	if ws.transaction != nil {
 		return ws.transaction.InsertInto(table)
@@ -421,3 +542,19 @@ func (ws writeSession) update(table string) *dbr.UpdateStmt {
 
 	return ws.session.Update(table)
 }
+
+func (ws writeSession) updateBySql(query string, value ...interface{}) *dbr.UpdateStmt {
+	if ws.transaction != nil {
+		return ws.transaction.UpdateBySql(query, value...)
+	}
+
+	return ws.session.UpdateBySql(query, value...)
+}
+
+func (ws writeSession) selectBySql(query string, value ...interface{}) *dbr.SelectStmt {
+	if ws.transaction != nil {
+		return ws.transaction.SelectBySql(query, value...)
+	}
+
+	return ws.session.SelectBySql(query, value...)
+}
@@ -25,6 +25,7 @@ type BrokerStorage interface {
 	Events() Events
 	InstancesArchived() InstancesArchived
 	Bindings() Bindings
+	QueueLeases() QueueLeases
 }
 
 const (
@@ -56,6 +57,7 @@ func NewFromConfig(cfg Config, evcfg events.Config, cipher postgres.Cipher, log
 		subaccountStates:  postgres.NewSubaccountStates(fact),
 		instancesArchived: postgres.NewInstanceArchived(fact),
 		bindings:          postgres.NewBinding(fact, cipher),
+		queueLeases:       postgres.NewQueueLeases(fact),
 	}, connection, nil
 }
 
@@ -70,6 +72,7 @@ func NewMemoryStorage() BrokerStorage {
 		subaccountStates:  memory.NewSubaccountStates(),
 		instancesArchived: memory.NewInstanceArchivedInMemoryStorage(),
 		bindings:          memory.NewBinding(),
+		queueLeases:       memory.NewQueueLeases(),
 	}
 }
 
@@ -132,6 +135,7 @@ type storage struct {
 	subaccountStates  SubaccountStates
 	instancesArchived InstancesArchived
 	bindings          Bindings
+	queueLeases       QueueLeases
 }
 
 func (s storage) Instances() Instances {
@@ -173,3 +177,7 @@ func (s storage) InstancesArchived() InstancesArchived {
 func (s storage) Bindings() Bindings {
 	return s.bindings
 }
+
+func (s storage) QueueLeases() QueueLeases {
+	return s.queueLeases
+}
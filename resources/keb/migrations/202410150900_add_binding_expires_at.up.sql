@@ -0,0 +1,7 @@
+ALTER TABLE bindings
+    ADD COLUMN expires_at TIMESTAMPTZ;
+
+UPDATE bindings SET expires_at = created_at + expiration_seconds * INTERVAL '1 second';
+
+ALTER TABLE bindings
+    ALTER COLUMN expires_at SET NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX bindings_by_operation_state;
+
+ALTER TABLE bindings
+    DROP COLUMN updated_at,
+    DROP COLUMN operation_type,
+    DROP COLUMN operation_state,
+    DROP COLUMN operation_description;
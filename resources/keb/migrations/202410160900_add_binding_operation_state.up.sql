@@ -0,0 +1,14 @@
+ALTER TABLE bindings
+    ADD COLUMN updated_at TIMESTAMPTZ,
+    -- type of the last binding operation: bind or unbind
+    ADD COLUMN operation_type VARCHAR(32) NOT NULL DEFAULT 'bind',
+    -- state of the last binding operation: in progress, succeeded or failed
+    ADD COLUMN operation_state VARCHAR(32) NOT NULL DEFAULT 'succeeded',
+    ADD COLUMN operation_description TEXT NOT NULL DEFAULT '';
+
+UPDATE bindings SET updated_at = created_at;
+
+ALTER TABLE bindings
+    ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX bindings_by_operation_state ON bindings USING btree (operation_state);
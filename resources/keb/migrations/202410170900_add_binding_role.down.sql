@@ -0,0 +1,3 @@
+ALTER TABLE bindings
+    DROP COLUMN role,
+    DROP COLUMN namespace;
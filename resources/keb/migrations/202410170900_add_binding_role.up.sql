@@ -0,0 +1,5 @@
+ALTER TABLE bindings
+    -- role profile granted to the service account of the binding
+    ADD COLUMN role VARCHAR(32) NOT NULL DEFAULT 'cluster-admin',
+    -- namespace the role is granted in, empty for cluster wide roles
+    ADD COLUMN namespace VARCHAR(255) NOT NULL DEFAULT '';
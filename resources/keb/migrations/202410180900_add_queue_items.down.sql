@@ -0,0 +1,3 @@
+DROP INDEX queue_items_by_not_before;
+
+DROP TABLE queue_items;
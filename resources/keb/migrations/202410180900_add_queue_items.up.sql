@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS queue_items (
+    queue_name VARCHAR(64) NOT NULL,
+    item_id VARCHAR(255) NOT NULL,
+    -- increased every time the item is added, a processed item is deleted only if it was not added again in the meantime
+    generation BIGINT NOT NULL DEFAULT 1,
+    -- the item is not claimed before this time
+    not_before TIMESTAMPTZ NOT NULL,
+    -- the broker replica processing the item, the lease is renewed while the item is processed
+    lease_owner VARCHAR(255),
+    lease_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (queue_name, item_id)
+);
+
+CREATE INDEX queue_items_by_not_before ON queue_items USING btree (queue_name, not_before);